@@ -0,0 +1,271 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"strings"
+	"sync/atomic"
+)
+
+const metaAdminServicePrefix = "/aribor.AdminService/"
+
+// AdminServiceServer exposes the ICP signal scope (USR1, USR2, HUP, TERM) as gRPC methods, it is served on
+// the primary or a separate admin port (ADMIN_PORT), both protected by a bearer token (ADMIN_TOKEN).
+type AdminServiceServer struct {}
+
+//
+// -- gRPC Admin Stack 7/n :: AdminService (signal mirror)
+//
+
+func (a AdminServiceServer) SeedFixtures(_ context.Context, req *rfpb.SeedFixturesReq) (*rfpb.SeedFixturesRes, error) {
+
+	log.Infof("%s: handle seed database admin call (profile: [%s]) ...",metaServiceName,req.GetProfile())
+
+	report, err := runtimeSeedFixtures(req.GetProfile())
+	if err == errFixtureSeedInProgress || err == errFixtureSeedProtected {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	return _getAdminSeedFixturesRes(report), nil
+}
+
+func (a AdminServiceServer) ToggleLatency(_ context.Context, _ *rfpb.ToggleLatencyReq) (*rfpb.ToggleLatencyRes, error) {
+
+	log.Infof("%s: handle fault injection admin call ...",metaServiceName)
+
+	state, err := runtimeToggleFaults()
+	res := &rfpb.ToggleLatencyRes{Faults: _getAdminFaultInjectionState(state)}
+	if err != nil {
+		res.Message = fmt.Sprintf("fault rules reload failed, keep previous rules: %v", err)
+	}
+
+	return res, nil
+}
+
+func (a AdminServiceServer) ReloadConfig(_ context.Context, _ *rfpb.ReloadConfigReq) (*rfpb.ReloadConfigRes, error) {
+
+	log.Infof("%s: handle reload config admin call ...",metaServiceName)
+
+	restart, err := runtimeReloadConfig()
+	if err != nil {
+		return &rfpb.ReloadConfigRes{Success: false, Message: err.Error()}, nil
+	}
+
+	if len(restart) > 0 {
+		return &rfpb.ReloadConfigRes{Success: true, Message: fmt.Sprintf("configuration reloaded, restart required for %s", strings.Join(restart, ", "))}, nil
+	}
+
+	return &rfpb.ReloadConfigRes{Success: true, Message: "configuration reloaded"}, nil
+}
+
+func (a AdminServiceServer) Drain(_ context.Context, req *rfpb.DrainReq) (*rfpb.DrainRes, error) {
+
+	log.Infof("%s: handle drain admin call ...",metaServiceName)
+
+	timeout := configCurrent().DrainTimeout
+	if req.GetTimeout() != nil {
+		d, err := ptypes.Duration(req.GetTimeout())
+		if err != nil || d < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid drain timeout: %v", req.GetTimeout())
+		}
+		timeout = d
+	}
+
+	return &rfpb.DrainRes{Draining: true, InflightRequests: runtimeDrain(timeout)}, nil
+}
+
+func (a AdminServiceServer) GetRuntimeState(_ context.Context, _ *rfpb.RuntimeStateReq) (*rfpb.RuntimeStateRes, error) {
+
+	tsStartedAt, _ := ptypes.TimestampProto(metaRuntime.startedAt)
+	mongoDbConnected, mongoDbChangedAt := runtimeMongoDbState()
+
+	res := &rfpb.RuntimeStateRes{
+		Version:          fmt.Sprintf("v%s", metaServiceVersion),
+		HealthStatus:     runtimeHealthStatus().String(),
+		LogLevel:         log.GetLevel().String(),
+		Draining:         runtimeIsDraining(),
+		InflightRequests: atomic.LoadInt64(&metaRuntime.inflight),
+		Faults:           _getAdminFaultInjectionState(metaFaults.state()),
+		StartedAt:        tsStartedAt,
+		MongodbConnected: mongoDbConnected,
+	}
+
+	if !mongoDbChangedAt.IsZero() {
+		res.MongodbChangedAt, _ = ptypes.TimestampProto(mongoDbChangedAt)
+	}
+
+	if lastSeed := runtimeLastSeed(); lastSeed != nil {
+		res.LastSeed = _getAdminSeedFixturesRes(lastSeed)
+	}
+
+	return res, nil
+}
+
+func (a AdminServiceServer) ListWebhookDeliveries(ctx context.Context, req *rfpb.ListWebhookDeliveriesReq) (*rfpb.ListWebhookDeliveriesRes, error) {
+
+	limit := _getAdminListLimit(req.GetLimit(), metaWebhookListLimit, metaWebhookListMaxLimit)
+	opCtx, cancel := mongoDbOperationContext(ctx, configCurrent().MongoDbOperationTimeout)
+	defer cancel()
+
+	deliveries, err := metaWebhooks.store.list(opCtx, webhookDeliveryFilter{Endpoint: req.GetEndpoint(), EventType: req.GetEventType(), Status: req.GetStatus()}, limit)
+	if err != nil {
+		return nil, mongoDbDomainError(opCtx, err, "")
+	}
+
+	res := &rfpb.ListWebhookDeliveriesRes{}
+	for i := range deliveries {
+		res.Deliveries = append(res.Deliveries, _getAdminWebhookDelivery(&deliveries[i]))
+	}
+
+	return res, nil
+}
+
+func (a AdminServiceServer) ListAuditEvents(ctx context.Context, req *rfpb.ListAuditEventsReq) (*rfpb.ListAuditEventsRes, error) {
+
+	opCtx, cancel := mongoDbOperationContext(ctx, configCurrent().MongoDbOperationTimeout)
+	defer cancel()
+
+	return auditListEvents(opCtx, req)
+}
+
+// adminAuthInterceptor checks the bearer token of all AdminService calls, other services pass through.
+// The token is looked up on every call, so a rotated token (SIGHUP) is effective immediately.
+func adminAuthInterceptor(getToken func() string) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+		token := getToken()
+		if token == "" || !strings.HasPrefix(info.FullMethod, metaAdminServicePrefix) {
+			return handler(ctx, req)
+		}
+
+		if _isAdminTokenValid(ctx, token) {
+			return handler(ctx, req)
+		}
+
+		log.Warnf("%s: admin call [%s] rejected, invalid or missing token",metaServiceName,info.FullMethod)
+
+		return nil, status.Errorf(codes.Unauthenticated, "invalid or missing admin token")
+	}
+}
+
+//
+// -- sidekick stack for AdminService helper methods
+//
+
+// _isAdminTokenValid reports a call authorized by the bearer token, an empty token authorizes nothing
+func _isAdminTokenValid(ctx context.Context, token string) bool {
+
+	if token == "" {
+		return false
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		if subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(v, "Bearer ")), []byte(token)) == 1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func _getAdminSeedFixturesRes(report *fixtureSeedReport) *rfpb.SeedFixturesRes {
+
+	tsStartedAt, _ := ptypes.TimestampProto(report.StartedAt)
+	tsFinishedAt, _ := ptypes.TimestampProto(report.FinishedAt)
+
+	res := &rfpb.SeedFixturesRes{
+		Success:    report.success(),
+		Partial:    report.partial(),
+		Message:    report.summary(),
+		Profile:    report.Profile,
+		Seed:       report.Seed,
+		StartedAt:  tsStartedAt,
+		FinishedAt: tsFinishedAt,
+	}
+
+	for _, role := range report.Roles {
+		res.Roles = append(res.Roles, &rfpb.SeedRoleReport{
+			Role:      role.Role,
+			Requested: int32(role.Requested),
+			Created:   int32(role.Created),
+			Failed:    int32(role.Failed),
+			Errors:    role.Errors,
+		})
+	}
+
+	return res
+}
+
+func _getAdminFaultInjectionState(state faultInjectorState) *rfpb.FaultInjectionState {
+
+	res := &rfpb.FaultInjectionState{Enabled: state.Enabled, RulesFile: state.File}
+	for _, rule := range state.Rules {
+		res.Rules = append(res.Rules, rule.Name)
+	}
+
+	return res
+}
+
+// _getAdminListLimit returns the requested limit, def if not set, capped at max
+func _getAdminListLimit(limit int32, def int, max int) int {
+
+	if limit <= 0 {
+		return def
+	} else if int(limit) > max {
+		return max
+	}
+
+	return int(limit)
+}
+
+func _getAdminWebhookDelivery(delivery *webhookDelivery) *rfpb.WebhookDelivery {
+
+	res := &rfpb.WebhookDelivery{
+		Id:             delivery.ID.Hex(),
+		Endpoint:       delivery.Endpoint,
+		EventId:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       int32(delivery.Attempts),
+		LastStatusCode: int32(delivery.LastStatusCode),
+		LastError:      delivery.LastError,
+	}
+
+	res.CreatedAt, _ = ptypes.TimestampProto(delivery.CreatedAt)
+	if delivery.Status == metaWebhookStatusPending {
+		res.NextAttemptAt, _ = ptypes.TimestampProto(delivery.NextAttemptAt)
+	}
+	if !delivery.DeliveredAt.IsZero() {
+		res.DeliveredAt, _ = ptypes.TimestampProto(delivery.DeliveredAt)
+	}
+
+	return res
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	rfpbh "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const adminTestToken = "test-admin-token"
+
+//
+// -- core test helper methods :: *.n
+//
+
+// runtimeTestReset puts metaRuntime back into serving state (neither draining nor shut down), the runtime itself is
+// kept since workers of former tests may still use it.
+func runtimeTestReset() {
+
+	metaRuntime.lifecycleMu.Lock()
+	defer metaRuntime.lifecycleMu.Unlock()
+
+	atomic.StoreInt32(&metaRuntime.draining, 0)
+	metaRuntime.drain, metaRuntime.shutdown = make(chan struct{}), make(chan struct{})
+	metaRuntime.operations = map[uint64]context.CancelFunc{}
+}
+
+func adminTestDialer() func(context.Context, string) (net.Conn, error) {
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(adminAuthInterceptor(func() string { return adminTestToken }), runtimeUnaryInterceptor),
+		grpc.ChainStreamInterceptor(runtimeStreamInterceptor),
+	)
+	rfpb.RegisterAdminServiceServer(server, &AdminServiceServer{})
+	rfpb.RegisterUserInviteCodeServiceServer(server, &UserInviteCodeServiceServer{})
+	rfpbh.RegisterHealthServer(server, &UserInviteCodeServiceServer{})
+	go func() { _ = server.Serve(listener) }()
+
+	return func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}
+}
+
+func adminTestContext() context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+adminTestToken)
+}
+
+//
+// -- core test methods :: AdminService
+//
+
+func TestAdminService_Unauthenticated(t *testing.T) {
+
+	conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(adminTestDialer()))
+	if err != nil { t.Fatal(err) }; defer conn.Close()
+
+	_, err = rfpb.NewAdminServiceClient(conn).GetRuntimeState(ctx, &rfpb.RuntimeStateReq{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// the invite code service itself is not affected by the admin token
+	_, err = rfpb.NewUserInviteCodeServiceClient(conn).GetVersion(ctx, &rfpb.VersionReq{})
+	assert.NoError(t, err)
+}
+
+func TestAdminService_ToggleLatency(t *testing.T) {
+
+	conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(adminTestDialer()))
+	if err != nil { t.Fatal(err) }; defer conn.Close()
+
+	client := rfpb.NewAdminServiceClient(conn)
+	enabled := metaFaults.state().Enabled
+
+	resToggle, err := client.ToggleLatency(adminTestContext(), &rfpb.ToggleLatencyReq{})
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, !enabled, resToggle.Faults.Enabled)
+
+	resState, err := client.GetRuntimeState(adminTestContext(), &rfpb.RuntimeStateReq{})
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, !enabled, resState.Faults.Enabled)
+	assert.Equal(t, "v"+metaServiceVersion, resState.Version)
+
+	metaFaults.setEnabled(enabled)
+}
+
+func TestAdminService_ReloadConfig(t *testing.T) {
+
+	conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(adminTestDialer()))
+	if err != nil { t.Fatal(err) }; defer conn.Close()
+
+	defer metaConfig.Store(configCurrent())
+	defer os.Unsetenv("DRAIN_TIMEOUT")
+	defer os.Unsetenv("PORT")
+	_ = os.Setenv("DRAIN_TIMEOUT", "7s")
+	_ = os.Setenv("PORT", strconv.Itoa(configCurrent().Port+1))
+
+	resReload, err := rfpb.NewAdminServiceClient(conn).ReloadConfig(adminTestContext(), &rfpb.ReloadConfigReq{})
+	if err != nil { t.Fatal(err) }
+
+	// reloaded settings are effective immediately, settings read on startup only are reported
+	assert.True(t, resReload.Success)
+	assert.Equal(t, 7*time.Second, configCurrent().DrainTimeout)
+	assert.Equal(t, "configuration reloaded, restart required for PORT", resReload.Message)
+}
+
+func TestAdminService_Drain(t *testing.T) {
+
+	conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(adminTestDialer()))
+	if err != nil { t.Fatal(err) }; defer conn.Close()
+	runtimeTestReset()
+	defer runtimeTestReset()
+
+	resDrain, err := rfpb.NewAdminServiceClient(conn).Drain(adminTestContext(), &rfpb.DrainReq{Timeout: ptypes.DurationProto(time.Second)})
+	if err != nil { t.Fatal(err) }
+	assert.True(t, resDrain.Draining)
+	assert.Equal(t, int64(0), resDrain.InflightRequests)
+
+	resHealth, err := rfpbh.NewHealthClient(conn).Check(ctx, &rfpbh.HealthCheckRequest{})
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, rfpbh.HealthCheckResponse_NOT_SERVING, resHealth.Status)
+
+	_, err = rfpb.NewUserInviteCodeServiceClient(conn).GetVersion(ctx, &rfpb.VersionReq{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	resState, err := rfpb.NewAdminServiceClient(conn).GetRuntimeState(adminTestContext(), &rfpb.RuntimeStateReq{})
+	if err != nil { t.Fatal(err) }
+	assert.True(t, resState.Draining)
+	assert.Equal(t, rfpbh.HealthCheckResponse_NOT_SERVING.String(), resState.HealthStatus)
+}
+
+func TestAdminService_SeedFixturesUnknownProfile(t *testing.T) {
+
+	conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(adminTestDialer()))
+	if err != nil { t.Fatal(err) }; defer conn.Close()
+
+	_, err = rfpb.NewAdminServiceClient(conn).SeedFixtures(adminTestContext(), &rfpb.SeedFixturesReq{Profile: "unknown"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
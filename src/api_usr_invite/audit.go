@@ -0,0 +1,445 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	metaAuditCollectionTbl    = "audit_events"
+	metaAuditHeaderRequestId  = "x-request-id"
+	metaAuditHeaderForwarded  = "x-forwarded-for"
+	metaAuditActorAnonymous   = "anonymous"
+	metaAuditActorAdmin       = "admin"
+	metaAuditRequestIdMaxSize = 128
+	metaAuditListLimit        = 100
+	metaAuditListMaxLimit     = 1000
+)
+
+type auditOperation string
+
+const (
+	auditOperationCreate auditOperation = "create"
+	auditOperationUpdate auditOperation = "update"
+	auditOperationDelete auditOperation = "delete"
+)
+
+var (
+	auditOperations = map[auditOperation]rfpb.AuditEvent_Operation{
+		auditOperationCreate: rfpb.AuditEvent_CREATE,
+		auditOperationUpdate: rfpb.AuditEvent_UPDATE,
+		auditOperationDelete: rfpb.AuditEvent_DELETE,
+	}
+
+	// metaAuditStore appends to audit_events of the current database (reconnects included)
+	metaAuditStore auditStore = &mongoDbAuditStore{collection: func() *mongo.Collection {
+		return mongoDbCurrent().database.Collection(metaAuditCollectionTbl)
+	}}
+)
+
+// auditEvent is written to audit_events in the transaction of the invite code write
+type auditEvent struct {
+	ID           primitive.ObjectID `bson:"_id"`
+	Operation    auditOperation     `bson:"operation"`
+	InviteCodeID primitive.ObjectID `bson:"invite_code_id"`
+	Actor        string             `bson:"actor"`
+	RequestID    string             `bson:"request_id"`
+	Method       string             `bson:"method"`
+	ClientIP     string             `bson:"client_ip"`
+	ForwardedFor string             `bson:"forwarded_for,omitempty"`
+	OccurredAt   time.Time          `bson:"occurred_at"`
+	Changes      []auditChange      `bson:"changes"`
+}
+
+type auditChange struct {
+	Field  string `bson:"field"`
+	Before string `bson:"before"`
+	After  string `bson:"after"`
+}
+
+// auditFilter selects audit events, zero fields match all, events before PageToken continue a listing
+type auditFilter struct {
+	InviteCodeID primitive.ObjectID
+	Actor        string
+	From         time.Time
+	To           time.Time
+	PageToken    primitive.ObjectID
+}
+
+// auditStore is append-only, there is no way to change or remove an audit event
+type auditStore interface {
+	append(ctx context.Context, event *auditEvent) error
+	list(ctx context.Context, filter auditFilter, limit int) ([]auditEvent, error)
+}
+
+type mongoDbAuditStore struct {
+	collection func() *mongo.Collection
+}
+
+// auditRequest identifies the RPC of an audit event
+type auditRequest struct {
+	ID     string
+	Method string
+}
+
+type auditRequestKey struct{}
+
+//
+// -- gRPC Audit Stack 25/n :: audit log of mutating invite code operations
+//
+
+// auditUnaryInterceptor assigns a request id to each call (x-request-id of the caller or a new one), the id is
+// returned as x-request-id header and recorded by audit events.
+func auditUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	if _isServiceInternalMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	requestID := primitive.NewObjectID().Hex()
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(metaAuditHeaderRequestId); len(ids) > 0 && ids[0] != "" && len(ids[0]) <= metaAuditRequestIdMaxSize {
+			requestID = ids[0]
+		}
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(metaAuditHeaderRequestId, requestID))
+
+	return handler(context.WithValue(ctx, auditRequestKey{}, auditRequest{ID: requestID, Method: info.FullMethod}), req)
+}
+
+// auditRecord appends the audit event of a write (before is nil on create), ctx is the context of outboxTransaction
+func auditRecord(ctx context.Context, operation auditOperation, before *UserInviteCode, after *UserInviteCode) error {
+
+	request, _ := ctx.Value(auditRequestKey{}).(auditRequest)
+	event := &auditEvent{
+		ID:         primitive.NewObjectID(),
+		Operation:  operation,
+		Actor:      _getAuditActor(ctx),
+		RequestID:  request.ID,
+		Method:     request.Method,
+		OccurredAt: time.Now().UTC().Truncate(time.Millisecond),
+		Changes:    _getAuditChanges(before, after),
+	}
+	event.ClientIP, event.ForwardedFor = _getAuditClient(ctx)
+
+	if after != nil {
+		event.InviteCodeID = after.ID
+	} else if before != nil {
+		event.InviteCodeID = before.ID
+	}
+
+	if err := metaAuditStore.append(ctx, event); err != nil {
+		return fmt.Errorf("unable to write audit event: %v", err)
+	}
+
+	return nil
+}
+
+// auditListEvents returns a page of audit events matching the request, newest first
+func auditListEvents(ctx context.Context, req *rfpb.ListAuditEventsReq) (*rfpb.ListAuditEventsRes, error) {
+
+	filter, err := _getAuditFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := _getAdminListLimit(req.GetLimit(), metaAuditListLimit, metaAuditListMaxLimit)
+	events, err := metaAuditStore.list(ctx, filter, limit)
+	if err != nil {
+		return nil, mongoDbDomainError(ctx, err, "")
+	}
+
+	res := &rfpb.ListAuditEventsRes{}
+	for i := range events {
+		res.Events = append(res.Events, _getAuditEventProto(&events[i]))
+	}
+
+	if len(events) == limit {
+		res.NextPageToken = events[len(events)-1].ID.Hex()
+	}
+
+	return res, nil
+}
+
+func (s *mongoDbAuditStore) append(ctx context.Context, event *auditEvent) error {
+
+	_, err := s.collection().InsertOne(ctx, event)
+
+	return err
+}
+
+func (s *mongoDbAuditStore) list(ctx context.Context, filter auditFilter, limit int) ([]auditEvent, error) {
+
+	query := bson.M{}
+	if !filter.InviteCodeID.IsZero() {
+		query["invite_code_id"] = filter.InviteCodeID
+	}
+	if filter.Actor != "" {
+		query["actor"] = filter.Actor
+	}
+	if !filter.PageToken.IsZero() {
+		query["_id"] = bson.M{"$lt": filter.PageToken}
+	}
+
+	occurredAt := bson.M{}
+	if !filter.From.IsZero() {
+		occurredAt["$gte"] = filter.From
+	}
+	if !filter.To.IsZero() {
+		occurredAt["$lt"] = filter.To
+	}
+	if len(occurredAt) > 0 {
+		query["occurred_at"] = occurredAt
+	}
+
+	cursor, err := s.collection().Find(ctx, query, options.Find().SetSort(bson.M{"_id": -1}).SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+
+	var events []auditEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// mongoDbAuditIndexModels returns the indexes of ListAuditEvents (newest first per invite code, actor and time)
+func mongoDbAuditIndexModels() []mongo.IndexModel {
+
+	return []mongo.IndexModel{
+		{Keys: bson.D{{Key: "invite_code_id", Value: 1}, {Key: "_id", Value: -1}}, Options: options.Index().SetName("invite_code_id__id")},
+		{Keys: bson.D{{Key: "actor", Value: 1}, {Key: "_id", Value: -1}}, Options: options.Index().SetName("actor__id")},
+		{Keys: bson.D{{Key: "occurred_at", Value: -1}}, Options: options.Index().SetName("occurred_at")},
+	}
+}
+
+// _validateAudit returns all audit configuration errors
+func (c *Config) _validateAudit() []string {
+
+	var errs []string
+	if c.AuditActorHeader == "" || c.AuditActorHeader != strings.ToLower(c.AuditActorHeader) {
+		errs = append(errs, fmt.Sprintf("AUDIT_ACTOR_HEADER: must be a lower case header name, got [%s]", c.AuditActorHeader))
+	}
+
+	if _, err := c.auditTrustedProxies(); err != nil {
+		errs = append(errs, fmt.Sprintf("AUDIT_TRUSTED_PROXIES: %v", err))
+	}
+
+	return errs
+}
+
+// auditTrustedProxies returns the networks of AUDIT_TRUSTED_PROXIES (CIDRs or single addresses, comma separated)
+func (c *Config) auditTrustedProxies() ([]*net.IPNet, error) {
+
+	var proxies []*net.IPNet
+	for _, proxy := range strings.Split(c.AuditTrustedProxies, ",") {
+		if proxy = strings.TrimSpace(proxy); proxy == "" {
+			continue
+		}
+
+		if ip := net.ParseIP(proxy); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8 * net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address or CIDR [%s]", proxy)
+		}
+		proxies = append(proxies, network)
+	}
+
+	return proxies, nil
+}
+
+//
+// -- sidekick stack for audit helper methods
+//
+
+// _getAuditActor returns the actor of verified credentials only: admin for calls with the admin token, the actor
+// header (AUDIT_ACTOR_HEADER) for calls of a trusted proxy (AUDIT_TRUSTED_PROXIES), anonymous otherwise. Clients
+// can send the header as well, the proxy has to strip it from their requests.
+func _getAuditActor(ctx context.Context) string {
+
+	if configCurrent() == nil {
+		return metaAuditActorAnonymous
+	}
+
+	if _isAdminTokenValid(ctx, metaSecrets.getAdminToken()) {
+		return metaAuditActorAdmin
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok && _isAuditTrustedProxy(ctx) {
+		if actors := md.Get(configCurrent().AuditActorHeader); len(actors) > 0 && actors[0] != "" {
+			return actors[0]
+		}
+	}
+
+	return metaAuditActorAnonymous
+}
+
+// _isAuditTrustedProxy reports a call whose peer address is one of AUDIT_TRUSTED_PROXIES
+func _isAuditTrustedProxy(ctx context.Context) bool {
+
+	clientIP, _ := _getAuditClient(ctx)
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return false
+	}
+
+	proxies, _ := configCurrent().auditTrustedProxies()
+	for _, proxy := range proxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// _getAuditClient returns the peer address and the forwarded-for chain of proxies (as sent, unverified)
+func _getAuditClient(ctx context.Context) (string, string) {
+
+	clientIP := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		clientIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(clientIP); err == nil {
+			clientIP = host
+		}
+	}
+
+	forwardedFor := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		forwardedFor = strings.Join(md.Get(metaAuditHeaderForwarded), ", ")
+	}
+
+	return clientIP, forwardedFor
+}
+
+// _getAuditChanges returns the fields which differ between before and after (all set fields on create)
+func _getAuditChanges(before *UserInviteCode, after *UserInviteCode) []auditChange {
+
+	fields := []string{"meta_code", "meta_for_app_role", "meta_valid_from", "meta_valid_to", "is_fixture", "is_test", "is_deleted", "deleted_at", "version"}
+	values := func(inviteCode *UserInviteCode) []string {
+		if inviteCode == nil {
+			return make([]string, len(fields))
+		}
+		return []string{
+			inviteCode.MetaCode, inviteCode.MetaForAppRole, _getAuditTime(inviteCode.MetaValidFrom), _getAuditTime(inviteCode.MetaValidTo),
+			strconv.FormatBool(inviteCode.IsFixture), strconv.FormatBool(inviteCode.IsTest), strconv.FormatBool(inviteCode.IsDeleted),
+			_getAuditTime(inviteCode.DeletedAt), strconv.FormatInt(inviteCode.Version, 10),
+		}
+	}
+
+	var changes []auditChange
+	beforeValues, afterValues := values(before), values(after)
+	for i, field := range fields {
+		if beforeValues[i] != afterValues[i] {
+			changes = append(changes, auditChange{Field: field, Before: beforeValues[i], After: afterValues[i]})
+		}
+	}
+
+	return changes
+}
+
+func _getAuditTime(t time.Time) string {
+
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func _getAuditFilter(req *rfpb.ListAuditEventsReq) (auditFilter, error) {
+
+	filter := auditFilter{Actor: req.GetActor()}
+
+	var err error
+	if req.GetInviteCodeId() != "" {
+		if filter.InviteCodeID, err = primitive.ObjectIDFromHex(req.GetInviteCodeId()); err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "Could not convert to ObjectId: %v", err)
+		}
+	}
+	if req.GetPageToken() != "" {
+		if filter.PageToken, err = primitive.ObjectIDFromHex(req.GetPageToken()); err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid page token [%s]", req.GetPageToken())
+		}
+	}
+
+	if req.GetFrom() != nil {
+		if filter.From, err = ptypes.Timestamp(req.GetFrom()); err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid from: %v", err)
+		}
+	}
+	if req.GetTo() != nil {
+		if filter.To, err = ptypes.Timestamp(req.GetTo()); err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid to: %v", err)
+		}
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return filter, status.Errorf(codes.InvalidArgument, "from (%s) must be before to (%s)", _getAuditTime(filter.From), _getAuditTime(filter.To))
+	}
+
+	return filter, nil
+}
+
+func _getAuditEventProto(event *auditEvent) *rfpb.AuditEvent {
+
+	res := &rfpb.AuditEvent{
+		Id:           event.ID.Hex(),
+		Operation:    auditOperations[event.Operation],
+		InviteCodeId: event.InviteCodeID.Hex(),
+		Actor:        event.Actor,
+		RequestId:    event.RequestID,
+		Method:       event.Method,
+		ClientIp:     event.ClientIP,
+		ForwardedFor: event.ForwardedFor,
+	}
+	res.OccurredAt, _ = ptypes.TimestampProto(event.OccurredAt)
+
+	for _, change := range event.Changes {
+		res.Changes = append(res.Changes, &rfpb.AuditChange{Field: change.Field, Before: change.Before, After: change.After})
+	}
+
+	return res
+}
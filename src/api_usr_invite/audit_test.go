@@ -0,0 +1,239 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"net"
+	"testing"
+	"time"
+)
+
+// auditTestStore appends audit events in memory
+type auditTestStore struct {
+	events []auditEvent
+}
+
+//
+// -- core test helper methods :: *.n
+//
+
+func (s *auditTestStore) append(_ context.Context, event *auditEvent) error {
+
+	s.events = append(s.events, *event)
+
+	return nil
+}
+
+func (s *auditTestStore) list(_ context.Context, filter auditFilter, limit int) ([]auditEvent, error) {
+
+	var events []auditEvent
+	for i := len(s.events) - 1; i >= 0 && len(events) < limit; i-- {
+		event := s.events[i]
+		if (!filter.InviteCodeID.IsZero() && event.InviteCodeID != filter.InviteCodeID) || (filter.Actor != "" && event.Actor != filter.Actor) ||
+			(!filter.PageToken.IsZero() && event.ID.Hex() >= filter.PageToken.Hex()) ||
+			(!filter.From.IsZero() && event.OccurredAt.Before(filter.From)) || (!filter.To.IsZero() && !event.OccurredAt.Before(filter.To)) {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// auditTestStoreSwap replaces the audit store until the returned func is called
+func auditTestStoreSwap() (*auditTestStore, func()) {
+
+	store, previous := &auditTestStore{}, metaAuditStore
+	metaAuditStore = store
+
+	return store, func() { metaAuditStore = previous }
+}
+
+// auditTestContext returns the context of a call by actor from 10.0.0.7 (via proxy)
+func auditTestContext(actor string, requestID string) context.Context {
+
+	callCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(configCurrent().AuditActorHeader, actor, metaAuditHeaderForwarded, "203.0.113.9"))
+	callCtx = peer.NewContext(callCtx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.7"), Port: 43512}})
+
+	return context.WithValue(callCtx, auditRequestKey{}, auditRequest{ID: requestID, Method: "/aribor.UserInviteCodeService/DeleteInviteCode"})
+}
+
+//
+// -- core test methods :: audit log
+//
+
+func TestAudit_Changes(t *testing.T) {
+
+	validTo := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	before := &UserInviteCode{ID: primitive.NewObjectID(), MetaCode: "audit", MetaForAppRole: "admin", MetaValidTo: validTo, Version: 1}
+
+	changes := _getAuditChanges(nil, before)
+	assert.Contains(t, changes, auditChange{Field: "meta_code", After: "audit"})
+	assert.Contains(t, changes, auditChange{Field: "meta_valid_to", After: "2020-10-01T12:00:00Z"})
+	assert.NotContains(t, changes, auditChange{Field: "meta_valid_from"}, "unset fields are skipped")
+
+	after := *before
+	after.IsDeleted, after.DeletedAt, after.Version = true, validTo.Add(time.Hour), 2
+	assert.Equal(t, []auditChange{
+		{Field: "is_deleted", Before: "false", After: "true"},
+		{Field: "deleted_at", After: "2020-10-01T13:00:00Z"},
+		{Field: "version", Before: "1", After: "2"},
+	}, _getAuditChanges(before, &after))
+
+	assert.Empty(t, _getAuditChanges(before, before))
+}
+
+func TestAudit_Record(t *testing.T) {
+
+	store, restore := auditTestStoreSwap()
+	defer restore()
+
+	proxies := configCurrent().AuditTrustedProxies
+	defer func() { configCurrent().AuditTrustedProxies = proxies }()
+	configCurrent().AuditTrustedProxies = "10.0.0.0/8"
+
+	inviteCode := &UserInviteCode{ID: primitive.NewObjectID(), MetaCode: "audit", MetaForAppRole: "admin"}
+	deleted := *inviteCode
+	deleted.IsDeleted = true
+
+	assert.NoError(t, auditRecord(auditTestContext("jane@aribor.io", "req-1"), auditOperationDelete, inviteCode, &deleted))
+	assert.Len(t, store.events, 1)
+
+	event := store.events[0]
+	assert.Equal(t, auditOperationDelete, event.Operation)
+	assert.Equal(t, inviteCode.ID, event.InviteCodeID)
+	assert.Equal(t, "jane@aribor.io", event.Actor)
+	assert.Equal(t, "req-1", event.RequestID)
+	assert.Equal(t, "/aribor.UserInviteCodeService/DeleteInviteCode", event.Method)
+	assert.Equal(t, "10.0.0.7", event.ClientIP)
+	assert.Equal(t, "203.0.113.9", event.ForwardedFor)
+	assert.Equal(t, []auditChange{{Field: "is_deleted", Before: "false", After: "true"}}, event.Changes)
+
+	// calls without actor header are recorded as anonymous
+	assert.NoError(t, auditRecord(ctx, auditOperationCreate, nil, inviteCode))
+	assert.Equal(t, metaAuditActorAnonymous, store.events[1].Actor)
+	assert.Equal(t, inviteCode.ID, store.events[1].InviteCodeID)
+
+	// the actor header of other peers is ignored
+	configCurrent().AuditTrustedProxies = "10.0.0.8, 192.168.0.0/16"
+	assert.NoError(t, auditRecord(auditTestContext("jane@aribor.io", "req-2"), auditOperationDelete, inviteCode, &deleted))
+	assert.Equal(t, metaAuditActorAnonymous, store.events[2].Actor)
+}
+
+func TestAudit_Actor(t *testing.T) {
+
+	proxies := configCurrent().AuditTrustedProxies
+	defer func() { configCurrent().AuditTrustedProxies = proxies }()
+	configCurrent().AuditTrustedProxies = "10.0.0.7"
+
+	assert.Equal(t, "jane@aribor.io", _getAuditActor(auditTestContext("jane@aribor.io", "req-1")))
+	assert.Equal(t, metaAuditActorAnonymous, _getAuditActor(auditTestContext("", "req-1")))
+
+	configCurrent().AuditTrustedProxies = ""
+	assert.Equal(t, metaAuditActorAnonymous, _getAuditActor(auditTestContext("jane@aribor.io", "req-1")))
+
+	// calls with the admin token are recorded as admin, whatever the header says
+	metaSecrets.mu.Lock()
+	token := metaSecrets.adminToken
+	metaSecrets.adminToken = "s3cr3t"
+	metaSecrets.mu.Unlock()
+	defer func() { metaSecrets.mu.Lock(); metaSecrets.adminToken = token; metaSecrets.mu.Unlock() }()
+
+	adminCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer s3cr3t", configCurrent().AuditActorHeader, "jane@aribor.io"))
+	assert.Equal(t, metaAuditActorAdmin, _getAuditActor(adminCtx))
+	adminCtx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer guess", configCurrent().AuditActorHeader, "jane@aribor.io"))
+	assert.Equal(t, metaAuditActorAnonymous, _getAuditActor(adminCtx))
+}
+
+func TestAudit_RequestId(t *testing.T) {
+
+	var request auditRequest
+	handler := func(handlerCtx context.Context, _ interface{}) (interface{}, error) {
+		request, _ = handlerCtx.Value(auditRequestKey{}).(auditRequest)
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/aribor.UserInviteCodeService/CreateInviteCode"}
+
+	_, _ = auditUnaryInterceptor(metadata.NewIncomingContext(ctx, metadata.Pairs(metaAuditHeaderRequestId, "envoy-4711")), nil, info, handler)
+	assert.Equal(t, auditRequest{ID: "envoy-4711", Method: info.FullMethod}, request)
+
+	_, _ = auditUnaryInterceptor(ctx, nil, info, handler)
+	assert.Len(t, request.ID, 24, "generated")
+}
+
+func TestAudit_ListEvents(t *testing.T) {
+
+	store, restore := auditTestStoreSwap()
+	defer restore()
+
+	oid := primitive.NewObjectID()
+	base := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 150; i++ {
+		event := auditEvent{ID: primitive.NewObjectID(), Operation: auditOperationUpdate, InviteCodeID: primitive.NewObjectID(), Actor: "ci", OccurredAt: base.Add(time.Duration(i) * time.Minute)}
+		if i%50 == 0 {
+			event.InviteCodeID, event.Actor, event.Operation = oid, "jane@aribor.io", auditOperationDelete
+		}
+		store.events = append(store.events, event)
+	}
+
+	res, err := AdminServiceServer{}.ListAuditEvents(ctx, &rfpb.ListAuditEventsReq{})
+	assert.NoError(t, err)
+	assert.Len(t, res.GetEvents(), metaAuditListLimit)
+	assert.Equal(t, store.events[149].ID.Hex(), res.GetEvents()[0].GetId(), "newest first")
+
+	res, err = AdminServiceServer{}.ListAuditEvents(ctx, &rfpb.ListAuditEventsReq{PageToken: res.GetNextPageToken()})
+	assert.NoError(t, err)
+	assert.Len(t, res.GetEvents(), 50)
+	assert.Empty(t, res.GetNextPageToken())
+
+	res, err = AdminServiceServer{}.ListAuditEvents(ctx, &rfpb.ListAuditEventsReq{InviteCodeId: oid.Hex(), Actor: "jane@aribor.io"})
+	assert.NoError(t, err)
+	assert.Len(t, res.GetEvents(), 3)
+	assert.Equal(t, rfpb.AuditEvent_DELETE, res.GetEvents()[0].GetOperation())
+
+	from, _ := ptypes.TimestampProto(base.Add(10 * time.Minute))
+	to, _ := ptypes.TimestampProto(base.Add(20 * time.Minute))
+	res, err = AdminServiceServer{}.ListAuditEvents(ctx, &rfpb.ListAuditEventsReq{From: from, To: to})
+	assert.NoError(t, err)
+	assert.Len(t, res.GetEvents(), 10)
+
+	for _, req := range []*rfpb.ListAuditEventsReq{{InviteCodeId: "42"}, {PageToken: "next"}, {From: to, To: from}} {
+		_, err := AdminServiceServer{}.ListAuditEvents(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+}
+
+func TestAudit_Config(t *testing.T) {
+
+	assert.Empty(t, (&Config{AuditActorHeader: "x-actor"})._validateAudit())
+	assert.Len(t, (&Config{AuditActorHeader: "X-Actor"})._validateAudit(), 1)
+	assert.Len(t, (&Config{})._validateAudit(), 1)
+	assert.Empty(t, (&Config{AuditActorHeader: "x-actor", AuditTrustedProxies: "10.0.0.0/8, 192.168.1.2, ::1"})._validateAudit())
+	assert.Equal(t, []string{"AUDIT_TRUSTED_PROXIES: invalid address or CIDR [10.0.0.0/33]"},
+		(&Config{AuditActorHeader: "x-actor", AuditTrustedProxies: "10.0.0.0/33"})._validateAudit())
+}
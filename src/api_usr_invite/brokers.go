@@ -0,0 +1,271 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	metaEventBrokerNats      = "nats"
+	metaEventBrokerKafkaRest = "kafka-rest"
+
+	metaNatsDefaultPort     = "4222"
+	metaKafkaRestMediaType  = "application/vnd.kafka.json.v2+json"
+	metaKafkaRestAcceptType = "application/vnd.kafka.v2+json"
+)
+
+// natsBroker publishes to core NATS (PUB, confirmed by PING/PONG), the subject is OUTBOX_TOPIC + the event
+// type without "invite_code." prefix (e.g. aribor.invite_codes.created). The connection is opened on demand
+// and re-opened after errors.
+type natsBroker struct {
+	mu      sync.Mutex
+	url     *url.URL
+	topic   string
+	timeout time.Duration
+	conn    net.Conn
+	reader  *bufio.Reader
+}
+
+// kafkaRestBroker produces to OUTBOX_TOPIC using a Kafka REST proxy (v2 API), messages are keyed by invite code
+type kafkaRestBroker struct {
+	client *http.Client
+	url    string
+}
+
+type kafkaRestRecords struct {
+	Records []kafkaRestRecord `json:"records"`
+}
+
+type kafkaRestRecord struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+type kafkaRestOffsets struct {
+	Offsets []struct {
+		Partition int    `json:"partition"`
+		Offset    int64  `json:"offset"`
+		ErrorCode int    `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+//
+// -- gRPC Broker Stack 24/n :: event broker adapters (nats, kafka rest proxy)
+//
+
+func init() {
+	registerEventBroker(metaEventBrokerNats, newNatsBroker)
+	registerEventBroker(metaEventBrokerKafkaRest, newKafkaRestBroker)
+}
+
+// newNatsBroker returns the broker of OUTBOX_BROKER_URL (nats://[user:password@]host[:port])
+func newNatsBroker(cfg *Config) (eventBroker, error) {
+
+	u, err := url.Parse(cfg.OutboxBrokerURL)
+	if err != nil || u.Scheme != "nats" || u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid nats url [%s], expected nats://host[:port]", cfg.OutboxBrokerURL)
+	}
+
+	if u.Port() == "" {
+		u.Host = net.JoinHostPort(u.Hostname(), metaNatsDefaultPort)
+	}
+
+	return &natsBroker{url: u, topic: cfg.OutboxTopic, timeout: cfg.OutboxBrokerTimeout}, nil
+}
+
+// newKafkaRestBroker returns the broker of OUTBOX_BROKER_URL (http(s)://rest-proxy[:port])
+func newKafkaRestBroker(cfg *Config) (eventBroker, error) {
+
+	u, err := url.Parse(cfg.OutboxBrokerURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid kafka rest proxy url [%s], expected http(s)://host[:port]", cfg.OutboxBrokerURL)
+	}
+
+	return &kafkaRestBroker{
+		client: &http.Client{Timeout: cfg.OutboxBrokerTimeout},
+		url:    strings.TrimRight(u.String(), "/") + "/topics/" + url.PathEscape(cfg.OutboxTopic),
+	}, nil
+}
+
+func (b *natsBroker) publish(ctx context.Context, msg brokerMessage) error {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.conn == nil {
+		if err := b._connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	subject := b.topic + "." + strings.TrimPrefix(msg.Type, "invite_code.")
+	err := b._roundTrip(ctx, []byte(fmt.Sprintf("PUB %s %d\r\n%s\r\n", subject, len(msg.Payload), msg.Payload)))
+	if err != nil {
+		b._reset()
+	}
+
+	return err
+}
+
+func (b *natsBroker) close() error {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.conn == nil {
+		return nil
+	}
+
+	err := b.conn.Close()
+	b.conn, b.reader = nil, nil
+
+	return err
+}
+
+func (b *kafkaRestBroker) publish(ctx context.Context, msg brokerMessage) error {
+
+	body, err := json.Marshal(kafkaRestRecords{Records: []kafkaRestRecord{{Key: msg.Key, Value: msg.Payload}}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", metaKafkaRestMediaType)
+	req.Header.Set("Accept", metaKafkaRestAcceptType)
+
+	res, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	raw, _ := ioutil.ReadAll(io.LimitReader(res.Body, metaWebhookMaxBody))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("kafka rest proxy answered %s: %s", res.Status, bytes.TrimSpace(raw))
+	}
+
+	offsets := kafkaRestOffsets{}
+	if err := json.Unmarshal(raw, &offsets); err != nil {
+		return fmt.Errorf("unable to parse kafka rest proxy response: %v", err)
+	}
+
+	for _, offset := range offsets.Offsets {
+		if offset.Error != "" || offset.ErrorCode != 0 {
+			return fmt.Errorf("kafka rest proxy rejected record (code %d): %s", offset.ErrorCode, offset.Error)
+		}
+	}
+
+	return nil
+}
+
+func (b *kafkaRestBroker) close() error {
+	return nil
+}
+
+//
+// -- sidekick stack for broker helper methods
+//
+
+// _connect opens the connection: INFO (server), CONNECT (client), confirmed by PING/PONG (rejected credentials)
+func (b *natsBroker) _connect(ctx context.Context) error {
+
+	dialer := net.Dialer{Timeout: b.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", b.url.Host)
+	if err != nil {
+		return err
+	}
+	b.conn, b.reader = conn, bufio.NewReader(conn)
+
+	_ = b.conn.SetReadDeadline(time.Now().Add(b.timeout))
+	line, err := b.reader.ReadString('\n')
+	if err != nil || !strings.HasPrefix(line, "INFO ") {
+		b._reset()
+		return fmt.Errorf("no nats server at [%s]: %v", b.url.Host, err)
+	}
+
+	options := map[string]interface{}{"verbose": false, "pedantic": false, "lang": "go", "name": metaServiceName, "version": metaServiceVersion}
+	if user := b.url.User; user != nil {
+		options["user"] = user.Username()
+		options["pass"], _ = user.Password()
+	}
+
+	raw, _ := json.Marshal(options)
+	if err := b._roundTrip(ctx, []byte(fmt.Sprintf("CONNECT %s\r\n", raw))); err != nil {
+		b._reset()
+		return err
+	}
+
+	return nil
+}
+
+// _roundTrip writes the given commands followed by a PING and waits for the PONG, server errors are returned
+func (b *natsBroker) _roundTrip(ctx context.Context, commands []byte) error {
+
+	deadline := time.Now().Add(b.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	_ = b.conn.SetDeadline(deadline)
+
+	if _, err := b.conn.Write(append(commands, []byte("PING\r\n")...)); err != nil {
+		return err
+	}
+
+	for {
+		line, err := b.reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+
+		switch line = strings.TrimSpace(line); {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := b.conn.Write([]byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+func (b *natsBroker) _reset() {
+
+	if b.conn != nil {
+		_ = b.conn.Close()
+	}
+	b.conn, b.reader = nil, nil
+}
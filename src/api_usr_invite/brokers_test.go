@@ -0,0 +1,197 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// brokersTestNats is an embedded NATS stand-in (INFO, CONNECT, PUB, PING/PONG), publishing to denied subjects fails
+type brokersTestNats struct {
+	listener net.Listener
+	denied   string
+
+	mu          sync.Mutex
+	connects    []string
+	subjects    []string
+	payloads    []string
+	connections int
+}
+
+//
+// -- core test helper methods :: *.n
+//
+
+func brokersTestNatsServer(t *testing.T, denied string) *brokersTestNats {
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil { t.Fatal(err) }
+
+	server := &brokersTestNats{listener: listener, denied: denied}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+
+	return server
+}
+
+func (s *brokersTestNats) serve(conn net.Conn) {
+
+	defer conn.Close()
+
+	s.mu.Lock()
+	s.connections++
+	s.mu.Unlock()
+
+	reader := bufio.NewReader(conn)
+	_, _ = conn.Write([]byte("INFO {\"server_id\":\"stand-in\",\"max_payload\":1048576}\r\n"))
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		fields := strings.Fields(line)
+		s.mu.Lock()
+		switch fields[0] {
+		case "CONNECT":
+			s.connects = append(s.connects, strings.TrimSpace(strings.TrimPrefix(line, "CONNECT")))
+		case "PING":
+			_, _ = conn.Write([]byte("PONG\r\n"))
+		case "PUB":
+			size, _ := strconv.Atoi(fields[2])
+			payload := make([]byte, size+2)
+			_, _ = io.ReadFull(reader, payload)
+			if fields[1] == s.denied {
+				_, _ = conn.Write([]byte(fmt.Sprintf("-ERR 'Permissions Violation for Publish to \"%s\"'\r\n", fields[1])))
+				break
+			}
+			s.subjects = append(s.subjects, fields[1])
+			s.payloads = append(s.payloads, string(payload[:size]))
+		}
+		s.mu.Unlock()
+	}
+}
+
+//
+// -- core test methods :: event broker adapters
+//
+
+func TestBrokers_Nats(t *testing.T) {
+
+	server := brokersTestNatsServer(t, "aribor.invite_codes.deleted")
+	defer server.listener.Close()
+
+	broker, err := newEventBroker(&Config{OutboxBroker: metaEventBrokerNats, OutboxBrokerURL: "nats://relay:secret@" + server.listener.Addr().String(), OutboxTopic: "aribor.invite_codes", OutboxBrokerTimeout: time.Second})
+	if err != nil { t.Fatal(err) }
+	defer broker.close()
+
+	assert.NoError(t, broker.publish(ctx, brokerMessage{ID: "1", Type: metaInviteCodeEventCreated, Payload: []byte(`{"id":"1"}`)}))
+	assert.NoError(t, broker.publish(ctx, brokerMessage{ID: "2", Type: metaInviteCodeEventCreated, Payload: []byte(`{"id":"2"}`)}))
+
+	server.mu.Lock()
+	assert.Equal(t, []string{"aribor.invite_codes.created", "aribor.invite_codes.created"}, server.subjects)
+	assert.Equal(t, `{"id":"1"}`, server.payloads[0])
+	assert.Equal(t, 1, server.connections, "connection reused")
+
+	options := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal([]byte(server.connects[0]), &options))
+	assert.Equal(t, "relay", options["user"])
+	assert.Equal(t, "secret", options["pass"])
+	assert.Equal(t, false, options["verbose"])
+	server.mu.Unlock()
+
+	// server errors are returned, the next publish reconnects
+	err = broker.publish(ctx, brokerMessage{ID: "3", Type: metaInviteCodeEventDeleted, Payload: []byte(`{}`)})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Permissions Violation")
+
+	assert.NoError(t, broker.publish(ctx, brokerMessage{ID: "4", Type: metaInviteCodeEventCreated, Payload: []byte(`{}`)}))
+	server.mu.Lock()
+	assert.Equal(t, 2, server.connections)
+	server.mu.Unlock()
+
+	// unreachable servers fail within the timeout
+	unreachable, err := newNatsBroker(&Config{OutboxBrokerURL: "nats://127.0.0.1:1", OutboxBrokerTimeout: time.Second})
+	if err != nil { t.Fatal(err) }
+	assert.Error(t, unreachable.publish(ctx, brokerMessage{Type: metaInviteCodeEventCreated}))
+
+	for _, invalid := range []string{"http://localhost:4222", "nats://", "::"} {
+		_, err := newNatsBroker(&Config{OutboxBrokerURL: invalid})
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestBrokers_KafkaRest(t *testing.T) {
+
+	var records []kafkaRestRecord
+	response := `{"offsets":[{"partition":0,"offset":42,"error_code":null,"error":null}]}`
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/topics/aribor.invite_codes", req.URL.Path)
+		assert.Equal(t, metaKafkaRestMediaType, req.Header.Get("Content-Type"))
+
+		body := kafkaRestRecords{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		records = append(records, body.Records...)
+
+		if strings.Contains(response, "error_code\":500") {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		_, _ = w.Write([]byte(response))
+	}))
+	defer proxy.Close()
+
+	broker, err := newEventBroker(&Config{OutboxBroker: metaEventBrokerKafkaRest, OutboxBrokerURL: proxy.URL + "/", OutboxTopic: "aribor.invite_codes", OutboxBrokerTimeout: time.Second})
+	if err != nil { t.Fatal(err) }
+
+	assert.NoError(t, broker.publish(ctx, brokerMessage{ID: "1", Type: metaInviteCodeEventCreated, Key: "5f75a9c0", Payload: []byte(`{"id":"1"}`)}))
+	assert.Len(t, records, 1)
+	assert.Equal(t, "5f75a9c0", records[0].Key)
+	assert.JSONEq(t, `{"id":"1"}`, string(records[0].Value))
+
+	// rejected records and proxy errors fail the publish
+	response = `{"offsets":[{"partition":null,"offset":null,"error_code":40403,"error":"topic not found"}]}`
+	err = broker.publish(ctx, brokerMessage{Key: "5f75a9c0", Payload: []byte(`{}`)})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "topic not found")
+
+	response = `{"error_code":500,"message":"Internal Server Error"}`
+	assert.Error(t, broker.publish(ctx, brokerMessage{Key: "5f75a9c0", Payload: []byte(`{}`)}))
+	assert.NoError(t, broker.close())
+
+	_, err = newKafkaRestBroker(&Config{OutboxBrokerURL: "kafka://localhost:9092"})
+	assert.Error(t, err)
+}
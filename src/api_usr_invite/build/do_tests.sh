@@ -26,6 +26,7 @@ metaMongoDbTestRootPwd="dummyrootpwd123"
 metaMongoDbTestDbName="example_user_db"
 metaMongoDbTestUser="test_db_col_usr"
 metaMongoDbTestPwd="test_db_col_pwd123"
+metaMongoDbTestReplicaSetKey="dummyreplicasetkey123"
 metaRuntimeHash=$(openssl rand -hex 4)
 
 tear_up () {
@@ -42,6 +43,9 @@ tear_up () {
         -e MONGODB_USERNAME=${metaMongoDbTestUser} \
         -e MONGODB_PASSWORD=${metaMongoDbTestPwd} \
         -e MONGODB_DATABASE=${metaMongoDbTestDbName} \
+        -e MONGODB_REPLICA_SET_MODE=primary \
+        -e MONGODB_REPLICA_SET_KEY=${metaMongoDbTestReplicaSetKey} \
+        -e MONGODB_ADVERTISED_HOSTNAME=localhost \
         bitnami/mongodb:${metaMongoDbVersion} >/dev/null 2>&1
   else
       echo -e "script [$metaServiceCore/$metaServiceName/$SCRIPT_NAME] mongodb container (testing scope) is running <ignore>\n"
@@ -62,7 +66,7 @@ run_tests () {
   tear_up
 
   cd ${SCRIPT_PATH}
-  GOPATH=$HOME/go GOBIN=$HOME/go/bin DB_MONGO_USR=${metaMongoDbTestUser} DB_MONGO_PWD=${metaMongoDbTestPwd} DB_MONGO_LNK=mongodb://localhost:${metaMongoDbLocalTargetPort} DISABLE_DEBUG=1 go test ../ -mod=readonly -v
+  GOPATH=$HOME/go GOBIN=$HOME/go/bin DB_MONGO_USR=${metaMongoDbTestUser} DB_MONGO_PWD=${metaMongoDbTestPwd} DB_MONGO_LNK="mongodb://localhost:${metaMongoDbLocalTargetPort}/?directConnection=true" DISABLE_DEBUG=1 go test ../ -mod=readonly -v
   cd -
 
   tear_down
@@ -0,0 +1,136 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"strings"
+)
+
+const (
+	metaInviteCodeInitialVersion = int64(1)
+	metaHeaderIfMatch            = "if-match"
+	metaHeaderETag               = "etag"
+)
+
+//
+// -- gRPC Concurrency Stack 16/n :: optimistic concurrency by document versions
+//
+
+// inviteCodeExpectedVersion returns the version a write is conditioned on, the request field takes precedence over
+// the "if-match" header (e.g. HTTP clients of the gRPC-Web/Connect bridge). 0 means unconditional, as does "*".
+func inviteCodeExpectedVersion(ctx context.Context, expected int64) (int64, error) {
+
+	if expected < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid expected version: %d", expected))
+	}
+
+	if expected > 0 {
+		return expected, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(metaHeaderIfMatch)
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	if len(values) > 1 || strings.Contains(values[0], ",") {
+		return 0, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid %s header: a single etag is supported only", metaHeaderIfMatch))
+	}
+
+	version, err := _parseETag(values[0])
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Invalid %s header: %v", metaHeaderIfMatch, err))
+	}
+
+	return version, nil
+}
+
+// inviteCodeVersionFilter restricts the filter of a conditional write to the expected version
+func inviteCodeVersionFilter(filter bson.M, expected int64) bson.M {
+
+	if expected > 0 {
+		filter["version"] = expected
+	}
+
+	return filter
+}
+
+// inviteCodeVersionError is used if a write matched no document, with an expected version the document is looked up
+// again to tell a concurrent modification (VERSION_MISMATCH) from a missing document (CODE_NOT_FOUND).
+func inviteCodeVersionError(opCtx context.Context, oid primitive.ObjectID, expected int64, err error) error {
+
+	if !errors.Is(err, mongo.ErrNoDocuments) || expected == 0 {
+		return mongoDbDomainError(opCtx, err, oid.Hex())
+	}
+
+	current := UserInviteCode{}
+	lookupErr := mongoDbCurrent().collection.FindOne(opCtx, bson.M{"_id": oid, "is_deleted": false},
+		options.FindOne().SetProjection(bson.M{"version": 1})).Decode(&current)
+	if lookupErr != nil {
+		return mongoDbDomainError(opCtx, lookupErr, oid.Hex())
+	}
+
+	return newDomainError(reasonVersionMismatch, nil, map[string]string{
+		"id": oid.Hex(), "expected_version": strconv.FormatInt(expected, 10), "current_version": strconv.FormatInt(current.Version, 10),
+	}, "invite code has been modified concurrently, expected version %d, current version %d", expected, current.Version)
+}
+
+// inviteCodeSetETag sends the version of the returned invite code as "etag" header, ignored outside of an RPC
+func inviteCodeSetETag(ctx context.Context, version int64) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(metaHeaderETag, _getETag(version)))
+}
+
+//
+// -- sidekick stack for concurrency helper methods
+//
+
+func _getETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+func _parseETag(etag string) (int64, error) {
+
+	etag = strings.TrimSpace(etag)
+	if etag == "*" {
+		return 0, nil
+	}
+
+	if strings.HasPrefix(etag, "W/") {
+		return 0, fmt.Errorf("weak etag [%s] can not be used for a conditional write", etag)
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(etag, `"`), 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("etag [%s] is not a version", etag)
+	}
+
+	return version, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+//
+// -- core test methods :: optimistic concurrency
+//
+
+func TestConcurrency_ETag(t *testing.T) {
+
+	assert.Equal(t, `"3"`, _getETag(3))
+
+	for etag, version := range map[string]int64{`"3"`: 3, `3`: 3, ` "42" `: 42, `*`: 0} {
+		res, err := _parseETag(etag)
+		assert.NoError(t, err, etag)
+		assert.Equal(t, version, res, etag)
+	}
+
+	for _, etag := range []string{`W/"3"`, `"abc"`, `"0"`, `"-1"`, ``} {
+		_, err := _parseETag(etag)
+		assert.Error(t, err, etag)
+	}
+}
+
+func TestConcurrency_ExpectedVersion(t *testing.T) {
+
+	expected, err := inviteCodeExpectedVersion(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), expected)
+
+	// the if-match header is used if the request does not carry a version ...
+	ifMatchCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(metaHeaderIfMatch, `"5"`))
+	expected, err = inviteCodeExpectedVersion(ifMatchCtx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), expected)
+
+	// ... the request field takes precedence
+	expected, err = inviteCodeExpectedVersion(ifMatchCtx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), expected)
+
+	_, err = inviteCodeExpectedVersion(ctx, -1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = inviteCodeExpectedVersion(metadata.NewIncomingContext(ctx, metadata.Pairs(metaHeaderIfMatch, `"1", "2"`)), 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestConcurrency_VersionFilter(t *testing.T) {
+
+	assert.Equal(t, bson.M{"is_deleted": false}, inviteCodeVersionFilter(bson.M{"is_deleted": false}, 0))
+	assert.Equal(t, bson.M{"is_deleted": false, "version": int64(4)}, inviteCodeVersionFilter(bson.M{"is_deleted": false}, 4))
+}
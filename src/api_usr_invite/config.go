@@ -0,0 +1,483 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/joho/godotenv"
+	"github.com/sirupsen/logrus"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	metaConfigEnvFileDefault = ".env"
+	metaConfigRedacted       = "******"
+)
+
+// metaConfig holds the current *Config, a reload (SIGHUP, AdminService) replaces it as a whole
+var metaConfig atomic.Value
+
+// configLayer is a single configuration source, lookup reports whether a key is set at all
+type configLayer struct {
+	source string
+	lookup func(key string) (string, bool)
+}
+
+const (
+	configSourceDefault = "default"
+	configSourceEnvFile = "env-file"
+	configSourceEnv     = "env"
+	configSourceFlag    = "flag"
+)
+
+// Config holds the complete service configuration. Every field is bound to an environment variable
+// (tag env), a command line flag derived from it (DB_MONGO_USR -> --db-mongo-usr) and an optional
+// default value. Sources are applied in the order: defaults, .env file, environment, flags. Fields
+// tagged reload:"restart" are only read on startup, a reload reports their changes.
+type Config struct {
+	Environment                   string        `env:"ENVIRONMENT" usage:"deployment environment, fixture seeding is refused for production"`
+	Port                          int           `env:"PORT" reload:"restart" default:"50051" usage:"gRPC (and gRPC-Web/Connect) service port"`
+	DisableDebug                  bool          `env:"DISABLE_DEBUG" default:"false" usage:"log errors only"`
+	DisableTracing                bool          `env:"DISABLE_TRACING" reload:"restart" default:"true" usage:"disable jaeger tracing"`
+	DisableProfiler               bool          `env:"DISABLE_PROFILER" reload:"restart" default:"true" usage:"disable cloud profiler"`
+	DisableStats                  bool          `env:"DISABLE_STATS" reload:"restart" default:"true" usage:"disable opencensus gRPC stats and metric views"`
+	DisableWeb                    bool          `env:"DISABLE_WEB" reload:"restart" default:"false" usage:"serve native gRPC only (no gRPC-Web/Connect bridge)"`
+	JaegerServiceAddr             string        `env:"JAEGER_SERVICE_ADDR" reload:"restart" usage:"jaeger agent address"`
+	MongoDbUsr                    string        `env:"DB_MONGO_USR" usage:"mongodb service user"`
+	MongoDbPwd                    string        `env:"DB_MONGO_PWD" secret:"true" usage:"mongodb service password"`
+	MongoDbPDB                    string        `env:"DB_MONGO_PDB" reload:"restart" usage:"mongodb primary service database (auth source)"`
+	MongoDbLnk                    string        `env:"DB_MONGO_LNK" secret:"url" usage:"mongodb connection uri"`
+	MongoDbAuthMechanism          string        `env:"DB_MONGO_AUTH_MECHANISM" reload:"restart" usage:"mongodb auth mechanism (SCRAM-SHA-256, MONGODB-X509, ...), negotiated if unset"`
+	MongoDbAuthSource             string        `env:"DB_MONGO_AUTH_SOURCE" reload:"restart" usage:"mongodb auth database, defaults to DB_MONGO_PDB"`
+	MongoDbAppName                string        `env:"DB_MONGO_APP_NAME" reload:"restart" default:"grpc_usr_invite" usage:"application name reported to mongodb"`
+	MongoDbMaxPoolSize            int           `env:"DB_MONGO_MAX_POOL_SIZE" reload:"restart" default:"100" usage:"max. connections per mongodb server (0 = unlimited)"`
+	MongoDbMinPoolSize            int           `env:"DB_MONGO_MIN_POOL_SIZE" reload:"restart" default:"0" usage:"min. idle connections per mongodb server"`
+	MongoDbConnectTimeout         time.Duration `env:"DB_MONGO_CONNECT_TIMEOUT" reload:"restart" default:"10s" usage:"mongodb connect (and startup ping) timeout"`
+	MongoDbServerSelectionTimeout time.Duration `env:"DB_MONGO_SERVER_SELECTION_TIMEOUT" reload:"restart" default:"30s" usage:"max. time to find a suitable mongodb server per operation"`
+	MongoDbReadConcern            string        `env:"DB_MONGO_READ_CONCERN" reload:"restart" usage:"mongodb read concern (local, majority, ...), server default if unset"`
+	MongoDbWriteConcern           string        `env:"DB_MONGO_WRITE_CONCERN" reload:"restart" usage:"mongodb write concern (majority or number of nodes), server default if unset"`
+	MongoDbRetryWrites            bool          `env:"DB_MONGO_RETRY_WRITES" reload:"restart" default:"true" usage:"retry failed (retryable) writes once"`
+	MongoDbListReadPreference     string        `env:"DB_MONGO_LIST_READ_PREFERENCE" reload:"restart" default:"primary" usage:"read preference of the List RPCs (primary, secondaryPreferred, nearest, ...)"`
+	MongoDbConnectRetryTimeout    time.Duration `env:"DB_MONGO_CONNECT_RETRY_TIMEOUT" reload:"restart" default:"2m" usage:"max. total backoff waiting for mongodb on startup (0 = single attempt)"`
+	MongoDbConnectBackoff         time.Duration `env:"DB_MONGO_CONNECT_BACKOFF" reload:"restart" default:"500ms" usage:"initial backoff between startup connection attempts (doubled per attempt)"`
+	MongoDbConnectMaxBackoff      time.Duration `env:"DB_MONGO_CONNECT_MAX_BACKOFF" reload:"restart" default:"15s" usage:"max. backoff between startup connection attempts"`
+	MongoDbMonitorInterval        time.Duration `env:"DB_MONGO_MONITOR_INTERVAL" reload:"restart" default:"10s" usage:"connectivity check interval after startup (0 = disabled)"`
+	MongoDbOperationTimeout       time.Duration `env:"DB_MONGO_OPERATION_TIMEOUT" default:"10s" usage:"max. duration of a single database operation, capped by the RPC deadline (0 = RPC deadline only)"`
+	MongoDbListTimeout            time.Duration `env:"DB_MONGO_LIST_TIMEOUT" default:"5m" usage:"max. duration of a streamed List RPC query, capped by the RPC deadline (0 = RPC deadline only)"`
+	MongoDbListBatchSize          int           `env:"DB_MONGO_LIST_BATCH_SIZE" default:"100" usage:"cursor batch size of the List RPCs"`
+	MongoDbListMaxResults         int           `env:"DB_MONGO_LIST_MAX_RESULTS" default:"10000" usage:"max. number of results per List RPC, more results are truncated (0 = unlimited)"`
+	MigrateOnStartup              bool          `env:"DB_MONGO_MIGRATE_ON_STARTUP" reload:"restart" default:"true" usage:"apply pending schema migrations on startup"`
+	MigrationLockTTL              time.Duration `env:"DB_MONGO_MIGRATION_LOCK_TTL" default:"10m" usage:"expiry of the migration lock of a crashed instance (renewed per migration)"`
+	MigrationLockTimeout          time.Duration `env:"DB_MONGO_MIGRATION_LOCK_TIMEOUT" default:"2m" usage:"max. time to wait for the migration lock of another instance"`
+	InviteCodeRoles               string        `env:"INVITE_CODE_ROLES" default:"admin,director,teacher,viewer" usage:"comma separated roles invite codes can be created for"`
+	InviteCodeExpiryTTL           time.Duration `env:"INVITE_CODE_EXPIRY_TTL" reload:"restart" default:"0s" usage:"remove expired codes after this grace period by a TTL index, neither audited nor emitted as deleted event (0 = keep)"`
+	InviteCodeExpiryScanInterval  time.Duration `env:"INVITE_CODE_EXPIRY_SCAN_INTERVAL" reload:"restart" default:"1m" usage:"interval of the expiry event scheduler (0 = disabled)"`
+	InviteCodeExpiryWarning       time.Duration `env:"INVITE_CODE_EXPIRY_WARNING" reload:"restart" default:"24h" usage:"codes expiring within this period emit an expiring soon event (0 = none)"`
+	InviteCodeExpirySink          string        `env:"INVITE_CODE_EXPIRY_SINK" reload:"restart" default:"log" usage:"sink receiving expiry events"`
+	InviteCodeWatchMode           string        `env:"INVITE_CODE_WATCH_MODE" default:"auto" usage:"WatchInviteCodes source (auto, change-stream, poll), auto polls on standalone mongodb"`
+	InviteCodeWatchPollInterval   time.Duration `env:"INVITE_CODE_WATCH_POLL_INTERVAL" default:"2s" usage:"poll interval of WatchInviteCodes without change streams"`
+	WebhookEndpointsFile          string        `env:"WEBHOOK_ENDPOINTS_FILE" reload:"restart" usage:"webhook endpoint file (json), no webhooks without file"`
+	WebhookMaxAttempts            int           `env:"WEBHOOK_MAX_ATTEMPTS" reload:"restart" default:"8" usage:"max. delivery attempts of a webhook event per endpoint"`
+	WebhookBackoff                time.Duration `env:"WEBHOOK_BACKOFF" reload:"restart" default:"5s" usage:"backoff after the first failed delivery, doubled per attempt"`
+	WebhookMaxBackoff             time.Duration `env:"WEBHOOK_MAX_BACKOFF" reload:"restart" default:"1h" usage:"max. backoff between delivery attempts"`
+	WebhookTimeout                time.Duration `env:"WEBHOOK_TIMEOUT" reload:"restart" default:"10s" usage:"timeout of a webhook request"`
+	WebhookPollInterval           time.Duration `env:"WEBHOOK_POLL_INTERVAL" reload:"restart" default:"1s" usage:"interval of the webhook delivery worker (0 = disabled)"`
+	OutboxBroker                  string        `env:"OUTBOX_BROKER" reload:"restart" default:"inprocess" usage:"broker receiving the invite code events of the outbox (inprocess, nats, kafka-rest)"`
+	OutboxBrokerURL               string        `env:"OUTBOX_BROKER_URL" reload:"restart" usage:"broker url (nats://host:4222, http://kafka-rest-proxy:8082)"`
+	OutboxBrokerTimeout           time.Duration `env:"OUTBOX_BROKER_TIMEOUT" reload:"restart" default:"5s" usage:"timeout of a broker publish"`
+	OutboxTopic                   string        `env:"OUTBOX_TOPIC" reload:"restart" default:"aribor.invite_codes" usage:"kafka topic (nats subject prefix) of invite code events"`
+	OutboxPollInterval            time.Duration `env:"OUTBOX_POLL_INTERVAL" reload:"restart" default:"1s" usage:"interval of the outbox relay, writes wake it up immediately (0 = disabled)"`
+	OutboxBackoff                 time.Duration `env:"OUTBOX_BACKOFF" reload:"restart" default:"1s" usage:"backoff after the first failed publish, doubled per attempt"`
+	OutboxMaxBackoff              time.Duration `env:"OUTBOX_MAX_BACKOFF" reload:"restart" default:"1m" usage:"max. backoff between publish attempts"`
+	OutboxAllowNonTransactional   bool          `env:"OUTBOX_ALLOW_NON_TRANSACTIONAL" default:"false" usage:"write invite codes and events without transaction on standalone mongodb (a crash may lose events)"`
+	AuditActorHeader              string        `env:"AUDIT_ACTOR_HEADER" default:"x-actor" usage:"header of the authenticated actor recorded by audit events (set by the authenticating proxy)"`
+	AuditTrustedProxies           string        `env:"AUDIT_TRUSTED_PROXIES" usage:"addresses/CIDRs of proxies whose actor header is trusted (comma separated, proxies must strip it from client requests)"`
+	WebCORSOrigin                 string        `env:"WEB_CORS_ORIGIN" reload:"restart" default:".*" usage:"allowed gRPC-Web/Connect origins (regular expression)"`
+	AdminPort                     int           `env:"ADMIN_PORT" reload:"restart" default:"0" usage:"separate AdminService port (0 = disabled)"`
+	AdminToken                    string        `env:"ADMIN_TOKEN" secret:"true" usage:"AdminService bearer token"`
+	DrainTimeout                  time.Duration `env:"DRAIN_TIMEOUT" default:"5s" usage:"max. time to wait for in-flight calls on shutdown"`
+	FaultRulesFile                string        `env:"FAULT_RULES_FILE" reload:"restart" usage:"fault injection rule file (json)"`
+	FixtureProfilesFile           string        `env:"FIXTURE_PROFILES_FILE" reload:"restart" usage:"fixture profile file (yaml/json)"`
+	FixtureProfile                string        `env:"FIXTURE_PROFILE" usage:"fixture profile used by USR1"`
+	TLSCert                       string        `env:"TLS_CERT" secret:"true" usage:"PEM encoded TLS certificate (chain), enables TLS"`
+	TLSKey                        string        `env:"TLS_KEY" secret:"true" usage:"PEM encoded TLS private key"`
+	SigningKey                    string        `env:"SIGNING_KEY" secret:"true" usage:"HMAC signing key (min. 32 bytes)"`
+	SecretProvider                string        `env:"SECRET_PROVIDER" default:"encrypted-file" usage:"provider resolving secret://<name> values"`
+	SecretStoreFile               string        `env:"SECRET_STORE_FILE" usage:"encrypted secret store file (encrypted-file provider)"`
+	SecretStoreKey                string        `env:"SECRET_STORE_KEY" secret:"store" usage:"base64 encoded 32 byte secret store key"`
+
+	EnvFile         string
+	PrintConfig     bool
+	SealSecretStore string
+	Migrate         string
+	MigrateTo       int
+	MigrateDryRun   bool
+
+	args    []string
+	sources map[string]string
+}
+
+//
+// -- gRPC Config Stack 9/n :: typed configuration (defaults, .env, env, flags)
+//
+
+// loadConfig builds the configuration from all sources, the .env file is optional unless it has been
+// set explicitly (--env-file). Secrets may be provided as KEY_FILE (e.g. docker/kubernetes secret mounts)
+// or as secret://<name> reference of the configured secret provider. All parse and validation errors
+// are reported at once, the (invalid) configuration is returned as well for flag only modes.
+func loadConfig(args []string) (*Config, error) {
+
+	cfg := &Config{args: args, sources: map[string]string{}}
+
+	fs := flag.NewFlagSet(metaServiceName, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	fs.StringVar(&cfg.EnvFile, "env-file", metaConfigEnvFileDefault, "optional .env file")
+	fs.BoolVar(&cfg.PrintConfig, "print-config", false, "print the effective configuration (secrets redacted) and exit")
+	fs.StringVar(&cfg.SealSecretStore, "seal-secret-store", "", "encrypt a JSON secret map using SECRET_STORE_KEY to stdout and exit")
+	fs.StringVar(&cfg.Migrate, "migrate", "", "run schema migrations (up or down) and exit")
+	fs.IntVar(&cfg.MigrateTo, "migrate-to", -1, "target version of --migrate (up: latest, down: last applied one only)")
+	fs.BoolVar(&cfg.MigrateDryRun, "migrate-dry-run", false, "list the migrations --migrate would run without running them")
+
+	_forEachConfigField(cfg, func(f reflect.StructField, _ reflect.Value) {
+		fs.String(_getConfigFlagName(f.Tag.Get("env")), "", f.Tag.Get("usage"))
+		if f.Tag.Get("secret") != "" {
+			fs.String(_getConfigFlagName(f.Tag.Get("env")+"_FILE"), "", "file containing "+f.Tag.Get("usage"))
+		}
+	})
+
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("invalid command line: %v", err)
+	}
+
+	flags := map[string]string{}
+	fs.Visit(func(f *flag.Flag) { flags[f.Name] = f.Value.String() })
+
+	_, envFileSet := flags["env-file"]
+	dotEnv, err := godotenv.Read(cfg.EnvFile)
+	if err != nil && (envFileSet || !os.IsNotExist(err)) {
+		return nil, fmt.Errorf("unable to read env file [%s]: %v", cfg.EnvFile, err)
+	}
+
+	// -- defaults < .env file < environment < flags --
+	layers := []configLayer{
+		{configSourceFlag, func(key string) (string, bool) { v, ok := flags[_getConfigFlagName(key)]; return v, ok }},
+		{configSourceEnv, os.LookupEnv},
+		{configSourceEnvFile, func(key string) (string, bool) { v, ok := dotEnv[key]; return v, ok }},
+	}
+
+	var errs []string
+	_forEachConfigField(cfg, func(f reflect.StructField, v reflect.Value) {
+		key := f.Tag.Get("env")
+		raw, source, err := _lookupConfigValue(f, layers)
+		if source == "" {
+			return
+		}
+
+		cfg.sources[key] = source
+		if err == nil {
+			err = _setConfigValue(v, raw)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: invalid value [%s] (%s): %v", key, _getConfigRedactedRaw(f, raw), source, err))
+		}
+	})
+
+	errs = append(errs, cfg._resolveSecretRefs()...)
+
+	if err := cfg.validate(); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if len(errs) > 0 {
+		return cfg, fmt.Errorf("invalid configuration: %s", strings.Join(errs, "; "))
+	}
+
+	return cfg, nil
+}
+
+// configCurrent returns the current configuration (nil before configure)
+func configCurrent() *Config {
+
+	cfg, _ := metaConfig.Load().(*Config)
+
+	return cfg
+}
+
+// reload re-reads the configuration using the original command line, the current one is kept on errors.
+func (c *Config) reload() (*Config, error) {
+	return loadConfig(c.args)
+}
+
+// isExplicit reports a setting provided by a source other than its default (.env file, environment, flag)
+func (c *Config) isExplicit(key string) bool {
+
+	source := c.sources[key]
+
+	return source != "" && source != configSourceDefault
+}
+
+// restartChanges returns the keys of all reload:"restart" settings which differ in next
+func (c *Config) restartChanges(next *Config) []string {
+
+	var changed []string
+	current := reflect.ValueOf(c).Elem()
+	_forEachConfigField(next, func(f reflect.StructField, v reflect.Value) {
+		if f.Tag.Get("reload") == "restart" && !reflect.DeepEqual(v.Interface(), current.FieldByName(f.Name).Interface()) {
+			changed = append(changed, f.Tag.Get("env"))
+		}
+	})
+
+	return changed
+}
+
+func (c *Config) validate() error {
+
+	var errs []string
+	errs = append(errs, c._validateMongoDb()...)
+	errs = append(errs, c._validateMigrations()...)
+	errs = append(errs, c._validateExpiry()...)
+	errs = append(errs, c._validateWatch()...)
+	errs = append(errs, c._validateWebhooks()...)
+	errs = append(errs, c._validateOutbox()...)
+	errs = append(errs, c._validateAudit()...)
+
+	if c.Port < 1 || c.Port > 65535 {
+		errs = append(errs, fmt.Sprintf("PORT: must be within [1,65535], got %d", c.Port))
+	}
+
+	if c.AdminPort < 0 || c.AdminPort > 65535 {
+		errs = append(errs, fmt.Sprintf("ADMIN_PORT: must be within [0,65535], got %d", c.AdminPort))
+	} else if c.AdminPort == c.Port {
+		errs = append(errs, "ADMIN_PORT: must differ from PORT")
+	} else if c.AdminPort != 0 && c.AdminToken == "" {
+		errs = append(errs, "ADMIN_TOKEN: required if ADMIN_PORT is set")
+	}
+
+	if c.DrainTimeout < 0 {
+		errs = append(errs, fmt.Sprintf("DRAIN_TIMEOUT: must not be negative, got %v", c.DrainTimeout))
+	}
+
+	if len(c.inviteCodeRoles()) == 0 {
+		errs = append(errs, "INVITE_CODE_ROLES: at least one role is required")
+	}
+
+	if _, err := regexp.Compile(c.WebCORSOrigin); err != nil {
+		errs = append(errs, fmt.Sprintf("WEB_CORS_ORIGIN: invalid regular expression: %v", err))
+	}
+
+	if (c.TLSCert == "") != (c.TLSKey == "") {
+		errs = append(errs, "TLS_CERT/TLS_KEY: both or none must be set")
+	} else if c.TLSCert != "" {
+		if _, err := tls.X509KeyPair([]byte(c.TLSCert), []byte(c.TLSKey)); err != nil {
+			errs = append(errs, fmt.Sprintf("TLS_CERT/TLS_KEY: invalid key pair: %v", err))
+		}
+	}
+
+	if c.SigningKey != "" && len(c.SigningKey) < metaSecretSigningKeyMinBytes {
+		errs = append(errs, fmt.Sprintf("SIGNING_KEY: must have at least %d bytes", metaSecretSigningKeyMinBytes))
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// print writes the effective configuration (KEY=value # source) to w, secrets are redacted.
+func (c *Config) print(w io.Writer) {
+
+	_forEachConfigField(c, func(f reflect.StructField, v reflect.Value) {
+		key := f.Tag.Get("env")
+		source := c.sources[key]
+		if source == "" {
+			source = "unset"
+		}
+		_, _ = fmt.Fprintf(w, "%s=%s # %s\n", key, _getConfigRedactedValue(f, v), source)
+	})
+}
+
+// inviteCodeRoles returns the known roles of invite codes, empty entries are ignored
+func (c *Config) inviteCodeRoles() []string {
+
+	var roles []string
+	for _, role := range strings.Split(c.InviteCodeRoles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
+
+func (c *Config) logLevel() logrus.Level {
+
+	if c.DisableDebug {
+		return logrus.ErrorLevel
+	}
+
+	return logrus.DebugLevel
+}
+
+//
+// -- sidekick stack for config helper methods
+//
+
+func _forEachConfigField(c *Config, fn func(reflect.StructField, reflect.Value)) {
+
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Type().Field(i); f.Tag.Get("env") != "" {
+			fn(f, v.Field(i))
+		}
+	}
+}
+
+// _lookupConfigValue returns the raw value of a field and its source (empty if not set at all), secret
+// fields may be set as KEY_FILE instead of KEY on every layer (but not both on the same layer).
+func _lookupConfigValue(f reflect.StructField, layers []configLayer) (string, string, error) {
+
+	key := f.Tag.Get("env")
+	for _, layer := range layers {
+		raw, isSet := layer.lookup(key)
+		file, isFileSet := "", false
+		if f.Tag.Get("secret") != "" {
+			file, isFileSet = layer.lookup(key + "_FILE")
+		}
+
+		switch {
+		case isSet && isFileSet:
+			return "", layer.source, fmt.Errorf("%s and %s_FILE are mutually exclusive", key, key)
+		case isFileSet:
+			content, err := ioutil.ReadFile(file)
+			if err != nil {
+				return "", layer.source + " file", err
+			}
+			return strings.TrimRight(string(content), "\r\n"), layer.source + " file", nil
+		case isSet:
+			return raw, layer.source, nil
+		}
+	}
+
+	if def, ok := f.Tag.Lookup("default"); ok {
+		return def, configSourceDefault, nil
+	}
+
+	return "", "", nil
+}
+
+// _resolveSecretRefs replaces secret://<name> values of secret fields, the provider is created lazily
+func (c *Config) _resolveSecretRefs() []string {
+
+	var provider secretProvider
+	var errs []string
+	getProvider := func() (secretProvider, error) {
+		var err error
+		if provider == nil {
+			provider, err = newSecretProvider(c)
+		}
+		return provider, err
+	}
+
+	_forEachConfigField(c, func(f reflect.StructField, v reflect.Value) {
+		if kind := f.Tag.Get("secret"); kind != "true" && kind != "url" {
+			return
+		}
+
+		key := f.Tag.Get("env")
+		value, isRef, err := _resolveConfigSecretRef(v.String(), getProvider)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", key, err))
+			return
+		}
+
+		if isRef {
+			v.SetString(value)
+			c.sources[key] += " (" + c.SecretProvider + ")"
+		}
+	})
+
+	return errs
+}
+
+func _setConfigValue(v reflect.Value, raw string) error {
+
+	raw = strings.TrimSpace(raw)
+	switch {
+	case v.Type() == reflect.TypeOf(time.Duration(0)):
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case v.Kind() == reflect.Int:
+		i, err := strconv.Atoi(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(i))
+	case v.Kind() == reflect.String:
+		v.SetString(raw)
+	default:
+		return fmt.Errorf("unsupported config type %s", v.Type())
+	}
+
+	return nil
+}
+
+func _getConfigRedactedValue(f reflect.StructField, v reflect.Value) string {
+	return _getConfigRedactedRaw(f, fmt.Sprintf("%v", v.Interface()))
+}
+
+func _getConfigRedactedRaw(f reflect.StructField, value string) string {
+
+	switch f.Tag.Get("secret") {
+	case "true", "store":
+		if value != "" {
+			return metaConfigRedacted
+		}
+	case "url":
+		if u, err := url.Parse(value); err == nil {
+			return u.Redacted()
+		}
+	}
+
+	return value
+}
+
+func _getConfigFlagName(key string) string {
+	return strings.ReplaceAll(strings.ToLower(key), "_", "-")
+}
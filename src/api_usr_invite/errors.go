@@ -0,0 +1,211 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// domainReason is the stable reason of a domain error (google.rpc.ErrorInfo reason), clients may rely on it
+type domainReason string
+
+const (
+	reasonCodeNotFound        domainReason = "CODE_NOT_FOUND"
+	reasonCodeExpired         domainReason = "CODE_EXPIRED"
+	reasonCodeAlreadyRedeemed domainReason = "CODE_ALREADY_REDEEMED"
+	reasonDuplicateCode       domainReason = "DUPLICATE_CODE"
+	reasonVersionMismatch     domainReason = "VERSION_MISMATCH"
+	reasonStoreUnavailable    domainReason = "STORE_UNAVAILABLE"
+	reasonInternal            domainReason = "INTERNAL"
+)
+
+// domainReasonCodes maps every reason to its gRPC code
+var domainReasonCodes = map[domainReason]codes.Code{
+	reasonCodeNotFound:        codes.NotFound,
+	reasonCodeExpired:         codes.FailedPrecondition,
+	reasonCodeAlreadyRedeemed: codes.FailedPrecondition,
+	reasonDuplicateCode:       codes.AlreadyExists,
+	reasonVersionMismatch:     codes.Aborted,
+	reasonStoreUnavailable:    codes.Unavailable,
+	reasonInternal:            codes.Internal,
+}
+
+// mongoDbDuplicateKeyCodes are the server error codes of unique index violations
+var mongoDbDuplicateKeyCodes = []int{11000, 11001, 12582}
+
+// domainError is sent to clients as status with google.rpc.ErrorInfo details, the cause is kept for logs only
+type domainError struct {
+	reason   domainReason
+	message  string
+	metadata map[string]string
+	cause    error
+}
+
+//
+// -- gRPC Error Stack 18/n :: domain errors (stable reasons, google.rpc.ErrorInfo)
+//
+
+func newDomainError(reason domainReason, cause error, metadata map[string]string, format string, args ...interface{}) *domainError {
+	return &domainError{reason: reason, message: fmt.Sprintf(format, args...), metadata: metadata, cause: cause}
+}
+
+func (e *domainError) Error() string {
+
+	if e.cause != nil {
+		return fmt.Sprintf("%s: %s: %v", e.reason, e.message, e.cause)
+	}
+
+	return fmt.Sprintf("%s: %s", e.reason, e.message)
+}
+
+func (e *domainError) Unwrap() error {
+	return e.cause
+}
+
+func (e *domainError) GRPCStatus() *status.Status {
+
+	code, ok := domainReasonCodes[e.reason]
+	if !ok {
+		code = codes.Internal
+	}
+
+	st := status.New(code, e.message)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: string(e.reason), Domain: metaServiceName, Metadata: e.metadata}); err == nil {
+		return detailed
+	}
+
+	return st
+}
+
+// mongoDbDomainError maps the error of a database operation on the invite code id (empty for queries) to a domain
+// error, the raw mongodb error is logged but never sent to the client. Cancellation and shutdown are reported as by
+// mongoDbStatusError.
+func mongoDbDomainError(opCtx context.Context, err error, id string) error {
+
+	if ctxErr := _getMongoDbContextError(opCtx, err); ctxErr != nil {
+		return ctxErr
+	}
+
+	var metadata map[string]string
+	if id != "" {
+		metadata = map[string]string{"id": id}
+	}
+
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return newDomainError(reasonCodeNotFound, nil, metadata, "invite code not found")
+	case _isMongoDbDuplicateKey(err):
+		log.Infof("%s: mongodb: duplicate invite code: %v",metaServiceName,err)
+		return newDomainError(reasonDuplicateCode, err, metadata, "invite code already exists")
+	case _isMongoDbUnavailable(err):
+		log.Warnf("%s: mongodb: store unavailable: %v",metaServiceName,err)
+		return newDomainError(reasonStoreUnavailable, err, metadata, "invite code store is unavailable, please retry")
+	}
+
+	log.Errorf("%s: mongodb: operation failed: %v",metaServiceName,err)
+
+	return newDomainError(reasonInternal, err, metadata, "internal error")
+}
+
+// inviteCodeWriteError maps the error of a write of the invite code with the given meta_code, a violation of the
+// unique meta_code index names the conflicting code (ErrorInfo metadata "meta_code").
+func inviteCodeWriteError(opCtx context.Context, err error, id string, code string) error {
+
+	if !_isMongoDbDuplicateKey(err) {
+		return mongoDbDomainError(opCtx, err, id)
+	}
+
+	metadata := map[string]string{"meta_code": code}
+	if id != "" {
+		metadata["id"] = id
+	}
+
+	log.Infof("%s: mongodb: duplicate invite code [%s]",metaServiceName,code)
+
+	return newDomainError(reasonDuplicateCode, err, metadata, "invite code [%s] already exists", code)
+}
+
+// domainErrorReason returns the reason of a domain error for logs, other errors are reported by their gRPC code
+func domainErrorReason(err error) string {
+
+	var domainErr *domainError
+	if errors.As(err, &domainErr) {
+		return string(domainErr.reason)
+	}
+
+	return status.Code(err).String()
+}
+
+//
+// -- sidekick stack for domain error helper methods
+//
+
+func _isMongoDbDuplicateKey(err error) bool {
+
+	var errCodes []int
+	var writeErr mongo.WriteException
+	var bulkErr mongo.BulkWriteException
+	var cmdErr mongo.CommandError
+
+	switch {
+	case errors.As(err, &writeErr):
+		for _, we := range writeErr.WriteErrors {
+			errCodes = append(errCodes, we.Code)
+		}
+	case errors.As(err, &bulkErr):
+		for _, we := range bulkErr.WriteErrors {
+			errCodes = append(errCodes, we.Code)
+		}
+	case errors.As(err, &cmdErr):
+		errCodes = append(errCodes, int(cmdErr.Code))
+	}
+
+	for _, code := range errCodes {
+		for _, duplicate := range mongoDbDuplicateKeyCodes {
+			if code == duplicate {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func _isMongoDbUnavailable(err error) bool {
+
+	var cmdErr mongo.CommandError
+	var connErr topology.ConnectionError
+
+	switch {
+	case errors.Is(err, mongo.ErrClientDisconnected), errors.As(err, &connErr):
+		return true
+	case errors.As(err, &cmdErr):
+		return cmdErr.HasErrorLabel("NetworkError")
+	}
+
+	// server selection errors are plain (wrapped) errors of the driver
+	return strings.Contains(err.Error(), topology.ErrServerSelectionTimeout.Error())
+}
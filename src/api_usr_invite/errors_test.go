@@ -0,0 +1,131 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+//
+// -- core test helper methods :: *.n
+//
+
+// errorsTestInfo returns the code and the google.rpc.ErrorInfo details of err
+func errorsTestInfo(t *testing.T, err error) (codes.Code, *errdetails.ErrorInfo) {
+
+	st := status.Convert(err)
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return st.Code(), info
+		}
+	}
+
+	t.Fatalf("no error info in status %v", st)
+
+	return st.Code(), nil
+}
+
+//
+// -- core test methods :: domain errors
+//
+
+func TestErrors_DomainError(t *testing.T) {
+
+	cause := errors.New("raw driver error")
+	err := newDomainError(reasonCodeExpired, cause, map[string]string{"id": "abc"}, "invite code expired at %s", "yesterday")
+
+	code, info := errorsTestInfo(t, err)
+	assert.Equal(t, codes.FailedPrecondition, code)
+	assert.Equal(t, "CODE_EXPIRED", info.GetReason())
+	assert.Equal(t, metaServiceName, info.GetDomain())
+	assert.Equal(t, map[string]string{"id": "abc"}, info.GetMetadata())
+
+	// the cause is kept for logs (and errors.Is) but not sent to the client
+	assert.Equal(t, "invite code expired at yesterday", status.Convert(err).Message())
+	assert.Contains(t, err.Error(), "raw driver error")
+	assert.True(t, errors.Is(err, cause))
+
+	for reason := range domainReasonCodes {
+		assert.NotEqual(t, codes.OK, status.Code(newDomainError(reason, nil, nil, "")), reason)
+	}
+}
+
+func TestErrors_MongoDbDomainError(t *testing.T) {
+
+	for _, tc := range []struct {
+		err    error
+		code   codes.Code
+		reason domainReason
+	}{
+		{mongo.ErrNoDocuments, codes.NotFound, reasonCodeNotFound},
+		{mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000, Message: "E11000 duplicate key error"}}}, codes.AlreadyExists, reasonDuplicateCode},
+		{mongo.CommandError{Code: 11000, Message: "E11000 duplicate key error"}, codes.AlreadyExists, reasonDuplicateCode},
+		{mongo.CommandError{Labels: []string{"NetworkError"}, Message: "connection reset"}, codes.Unavailable, reasonStoreUnavailable},
+		{mongo.ErrClientDisconnected, codes.Unavailable, reasonStoreUnavailable},
+		{errors.New("internal secret details"), codes.Internal, reasonInternal},
+	} {
+		err := mongoDbDomainError(ctx, tc.err, "5f5a0f3e1c9d440000a1b2c3")
+		code, info := errorsTestInfo(t, err)
+		assert.Equal(t, tc.code, code, tc.err.Error())
+		assert.Equal(t, string(tc.reason), info.GetReason(), tc.err.Error())
+		assert.Equal(t, "5f5a0f3e1c9d440000a1b2c3", info.GetMetadata()["id"])
+		assert.NotContains(t, status.Convert(err).Message(), tc.err.Error())
+	}
+
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Equal(t, codes.Canceled, status.Code(mongoDbDomainError(canceledCtx, canceledCtx.Err(), "")))
+}
+
+func TestErrors_DuplicateCode(t *testing.T) {
+
+	err := inviteCodeWriteError(ctx, mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}}, "", "code-1")
+	code, info := errorsTestInfo(t, err)
+	assert.Equal(t, codes.AlreadyExists, code)
+	assert.Equal(t, map[string]string{"meta_code": "code-1"}, info.GetMetadata())
+	assert.Equal(t, "invite code [code-1] already exists", status.Convert(err).Message())
+	assert.Equal(t, string(reasonDuplicateCode), domainErrorReason(err))
+	assert.Equal(t, "Canceled", domainErrorReason(status.Error(codes.Canceled, "canceled")))
+
+	// other errors are mapped as usual
+	code, _ = errorsTestInfo(t, inviteCodeWriteError(ctx, mongo.ErrClientDisconnected, "", "code-1"))
+	assert.Equal(t, codes.Unavailable, code)
+}
+
+func TestErrors_MongoDbStoreUnavailable(t *testing.T) {
+
+	cfg := &Config{MongoDbPDB: "db", MongoDbLnk: "mongodb://localhost:1/", MongoDbServerSelectionTimeout: 200 * time.Millisecond}
+	client, err := mongo.Connect(ctx, mongoDbClientOptions(cfg))
+	if err != nil { t.Fatal(err) }
+	defer client.Disconnect(ctx)
+
+	// a failed lookup on an unreachable database is not reported as NOT_FOUND
+	err = client.Database("db").Collection(metaMongoDbCollectionTbl).FindOne(ctx, bson.M{}).Err()
+	code, info := errorsTestInfo(t, mongoDbDomainError(ctx, err, ""))
+	assert.Equal(t, codes.Unavailable, code)
+	assert.Equal(t, string(reasonStoreUnavailable), info.GetReason())
+}
@@ -0,0 +1,377 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	metaExpirySinkLog     = "log"
+	metaExpiryTTLIndex    = "meta_valid_to_ttl"
+	metaExpiryScanBatch   = 100
+	metaExpiryScanTimeout = time.Minute
+)
+
+// expiryEventType is the type of an expiry event, emitted once per invite code (reset if meta_valid_to is updated)
+type expiryEventType string
+
+const (
+	expiryEventExpiringSoon expiryEventType = "invite_code.expiring_soon"
+	expiryEventExpired      expiryEventType = "invite_code.expired"
+)
+
+// expiryNotifiedFields marks the invite codes an event of the given type has been emitted for
+var expiryNotifiedFields = map[expiryEventType]string{
+	expiryEventExpiringSoon: "expiring_notified_at",
+	expiryEventExpired:      "expired_notified_at",
+}
+
+// expiryEvent is sent to the configured expiry sink
+type expiryEvent struct {
+	Type           expiryEventType `json:"type"`
+	ID             string          `json:"id"`
+	MetaCode       string          `json:"meta_code"`
+	MetaForAppRole string          `json:"meta_for_app_role"`
+	MetaValidTo    time.Time       `json:"meta_valid_to"`
+	EmittedAt      time.Time       `json:"emitted_at"`
+}
+
+// expiryCandidate is the projection of an invite code claimed for an expiry event
+type expiryCandidate struct {
+	ID             primitive.ObjectID `bson:"_id"`
+	MetaCode       string             `bson:"meta_code"`
+	MetaForAppRole string             `bson:"meta_for_app_role"`
+	MetaValidTo    time.Time          `bson:"meta_valid_to"`
+}
+
+// expirySink receives expiry events, sinks are registered by name and selected by INVITE_CODE_EXPIRY_SINK
+type expirySink interface {
+	emit(ctx context.Context, event expiryEvent) error
+}
+
+type expirySinkFactory func(cfg *Config) (expirySink, error)
+
+// expiryStore claims invite codes for an event atomically (one replica emits it), a claim is released if the
+// event could not be emitted, so the next scan retries it.
+type expiryStore interface {
+	claim(ctx context.Context, eventType expiryEventType, now time.Time, warning time.Duration) (*expiryCandidate, error)
+	release(ctx context.Context, eventType expiryEventType, id primitive.ObjectID) error
+}
+
+// expiryScheduler emits the events of at most batch codes per type and scan
+type expiryScheduler struct {
+	store   expiryStore
+	sink    expirySink
+	warning time.Duration
+	batch   int
+	now     func() time.Time
+}
+
+type logExpirySink struct{}
+
+type mongoDbExpiryStore struct {
+	collection func() *mongo.Collection
+}
+
+var (
+	expirySinkMu sync.RWMutex
+	expirySinks  = map[string]expirySinkFactory{
+		metaExpirySinkLog: func(*Config) (expirySink, error) { return logExpirySink{}, nil },
+	}
+
+	expirySchedulerOnce sync.Once
+)
+
+//
+// -- gRPC Expiry Stack 20/n :: expiry filtering, TTL index && expiry events
+//
+
+// inviteCodeExpiryFilter excludes codes with meta_valid_to in the past, unless expired codes are requested
+func inviteCodeExpiryFilter(filter bson.M, includeExpired bool, now time.Time) bson.M {
+
+	if !includeExpired {
+		filter["meta_valid_to"] = bson.M{"$gt": now}
+	}
+
+	return filter
+}
+
+// inviteCodeExpiredError returns CODE_EXPIRED if the invite code has expired at now
+func inviteCodeExpiredError(inviteCode *UserInviteCode, now time.Time) error {
+
+	if inviteCode.MetaValidTo.After(now) {
+		return nil
+	}
+
+	validTo := inviteCode.MetaValidTo.UTC().Format(time.RFC3339)
+
+	return newDomainError(reasonCodeExpired, nil, map[string]string{"id": inviteCode.ID.Hex(), "meta_valid_to": validTo},
+		"invite code expired at %s", validTo)
+}
+
+// mongoDbEnsureExpiryIndex creates (or adjusts) the TTL index removing codes grace after meta_valid_to, the index
+// is dropped if grace is 0. Ascending keys keep it apart from the (descending) validity index of migration 1. The
+// removals of mongodb are hard deletes, neither audited nor emitted as deleted event.
+func mongoDbEnsureExpiryIndex(collection *mongo.Collection, grace time.Duration) error {
+
+	ctx, cancel := context.WithTimeout(metaMongoDbContext, metaMongoDbIndexTimeout)
+	defer cancel()
+
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list indexes: %v", err)
+	}
+
+	var indexes []struct {
+		Name               string `bson:"name"`
+		ExpireAfterSeconds *int64 `bson:"expireAfterSeconds"`
+	}
+	if err := cursor.All(ctx, &indexes); err != nil {
+		return fmt.Errorf("unable to list indexes: %v", err)
+	}
+
+	seconds := int64(grace / time.Second)
+	for _, index := range indexes {
+		switch {
+		case index.Name != metaExpiryTTLIndex:
+			continue
+		case seconds <= 0:
+			_, err = collection.Indexes().DropOne(ctx, metaExpiryTTLIndex)
+			log.Infof("%s: mongodb: expiry index [%s] dropped",metaServiceName,metaExpiryTTLIndex)
+		case index.ExpireAfterSeconds == nil || *index.ExpireAfterSeconds != seconds:
+			err = collection.Database().RunCommand(ctx, bson.D{{Key: "collMod", Value: collection.Name()},
+				{Key: "index", Value: bson.M{"name": metaExpiryTTLIndex, "expireAfterSeconds": seconds}}}).Err()
+			log.Infof("%s: mongodb: expiry index [%s] grace changed to %v",metaServiceName,metaExpiryTTLIndex,grace)
+		}
+		return err
+	}
+
+	if seconds <= 0 {
+		return nil
+	}
+
+	_, err = collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bsonx.Doc{{Key: "meta_valid_to", Value: bsonx.Int32(1)}},
+		Options: options.Index().SetName(metaExpiryTTLIndex).SetExpireAfterSeconds(int32(seconds)),
+	})
+
+	return err
+}
+
+// registerExpirySink makes an expiry sink available for INVITE_CODE_EXPIRY_SINK, e.g. a message queue
+func registerExpirySink(name string, factory expirySinkFactory) {
+
+	expirySinkMu.Lock()
+	expirySinks[name] = factory
+	expirySinkMu.Unlock()
+}
+
+func newExpirySink(cfg *Config) (expirySink, error) {
+
+	expirySinkMu.RLock()
+	factory, ok := expirySinks[cfg.InviteCodeExpirySink]
+	expirySinkMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown expiry sink [%s]", cfg.InviteCodeExpirySink)
+	}
+
+	return factory(cfg)
+}
+
+// expiryStartScheduler scans the current collection for expiring and expired codes periodically (once per process,
+// until draining starts)
+func expiryStartScheduler(cfg *Config) {
+
+	if cfg.InviteCodeExpiryScanInterval <= 0 {
+		return
+	}
+
+	sink, err := newExpirySink(cfg)
+	if err != nil {
+		log.Errorf("%s: expiry: scheduler disabled: %v",metaServiceName,err)
+		return
+	}
+
+	scheduler := &expiryScheduler{
+		store:   &mongoDbExpiryStore{collection: func() *mongo.Collection { return mongoDbCurrent().collection }},
+		sink:    sink,
+		warning: cfg.InviteCodeExpiryWarning,
+		batch:   metaExpiryScanBatch,
+		now:     time.Now,
+	}
+
+	expirySchedulerOnce.Do(func() {
+		drain := runtimeDrainStarted()
+		go func() {
+			ticker := time.NewTicker(cfg.InviteCodeExpiryScanInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-drain:
+					return
+				case <-ticker.C:
+				}
+
+				scanCtx, cancel := context.WithTimeout(metaMongoDbContext, metaExpiryScanTimeout)
+				if emitted, err := scheduler.scan(scanCtx); err != nil {
+					log.Warnf("%s: expiry: scan failed after %d event(s): %v",metaServiceName,emitted,err)
+				}
+				cancel()
+			}
+		}()
+	})
+
+	log.Infof("%s: expiry: scheduler started (interval=%v, warning=%v, sink=%s)",metaServiceName,
+		cfg.InviteCodeExpiryScanInterval,cfg.InviteCodeExpiryWarning,cfg.InviteCodeExpirySink)
+}
+
+// scan emits the events of all claimed codes, it stops at the first sink error (the claim is released)
+func (s *expiryScheduler) scan(ctx context.Context) (int, error) {
+
+	emitted, now := 0, s.now()
+	for _, eventType := range []expiryEventType{expiryEventExpired, expiryEventExpiringSoon} {
+		if eventType == expiryEventExpiringSoon && s.warning <= 0 {
+			continue
+		}
+
+		for i := 0; i < s.batch; i++ {
+			candidate, err := s.store.claim(ctx, eventType, now, s.warning)
+			if err != nil || candidate == nil {
+				if err != nil {
+					return emitted, fmt.Errorf("unable to claim %s: %v", eventType, err)
+				}
+				break
+			}
+
+			event := expiryEvent{Type: eventType, ID: candidate.ID.Hex(), MetaCode: candidate.MetaCode,
+				MetaForAppRole: candidate.MetaForAppRole, MetaValidTo: candidate.MetaValidTo, EmittedAt: now}
+			if err := s.sink.emit(ctx, event); err != nil {
+				if releaseErr := s.store.release(ctx, eventType, candidate.ID); releaseErr != nil {
+					log.Warnf("%s: expiry: unable to release %s of [%s]: %v",metaServiceName,eventType,event.ID,releaseErr)
+				}
+				return emitted, fmt.Errorf("unable to emit %s of [%s]: %v", eventType, event.ID, err)
+			}
+			emitted++
+		}
+	}
+
+	return emitted, nil
+}
+
+func (logExpirySink) emit(_ context.Context, event expiryEvent) error {
+
+	log.Infof("%s: expiry: %s [%s] code [%s] role [%s] valid to %s",metaServiceName,event.Type,event.ID,
+		event.MetaCode,event.MetaForAppRole,event.MetaValidTo.UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+func (s *mongoDbExpiryStore) claim(ctx context.Context, eventType expiryEventType, now time.Time, warning time.Duration) (*expiryCandidate, error) {
+
+	field := expiryNotifiedFields[eventType]
+	filter := bson.M{"is_deleted": false, field: bson.M{"$exists": false}, "meta_valid_to": bson.M{"$lte": now}}
+	if eventType == expiryEventExpiringSoon {
+		filter["meta_valid_to"] = bson.M{"$gt": now, "$lte": now.Add(warning)}
+	}
+
+	candidate := &expiryCandidate{}
+	err := s.collection().FindOneAndUpdate(ctx, filter, bson.M{"$set": bson.M{field: now}},
+		options.FindOneAndUpdate().SetSort(bson.M{"meta_valid_to": 1}).SetProjection(bson.M{"meta_code": 1, "meta_for_app_role": 1, "meta_valid_to": 1}),
+	).Decode(candidate)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	return candidate, err
+}
+
+func (s *mongoDbExpiryStore) release(ctx context.Context, eventType expiryEventType, id primitive.ObjectID) error {
+
+	_, err := s.collection().UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$unset": bson.M{expiryNotifiedFields[eventType]: ""}})
+
+	return err
+}
+
+// _validateExpiry returns all expiry configuration errors
+func (c *Config) _validateExpiry() []string {
+
+	var errs []string
+	if c.InviteCodeExpiryTTL < 0 {
+		errs = append(errs, fmt.Sprintf("INVITE_CODE_EXPIRY_TTL: must not be negative, got %v", c.InviteCodeExpiryTTL))
+	}
+
+	if c.InviteCodeExpiryScanInterval < 0 {
+		errs = append(errs, fmt.Sprintf("INVITE_CODE_EXPIRY_SCAN_INTERVAL: must not be negative, got %v", c.InviteCodeExpiryScanInterval))
+	}
+
+	if c.InviteCodeExpiryWarning < 0 {
+		errs = append(errs, fmt.Sprintf("INVITE_CODE_EXPIRY_WARNING: must not be negative, got %v", c.InviteCodeExpiryWarning))
+	}
+
+	expirySinkMu.RLock()
+	_, ok := expirySinks[c.InviteCodeExpirySink]
+	expirySinkMu.RUnlock()
+	if !ok {
+		errs = append(errs, fmt.Sprintf("INVITE_CODE_EXPIRY_SINK: must be one of [%s], got %s", _getExpirySinkNames(), c.InviteCodeExpirySink))
+	}
+
+	return errs
+}
+
+//
+// -- sidekick stack for expiry helper methods
+//
+
+// _getExpiryResetUpdate clears the notification marks of a code whose meta_valid_to is updated
+func _getExpiryResetUpdate() bson.M {
+
+	reset := bson.M{}
+	for _, field := range expiryNotifiedFields {
+		reset[field] = ""
+	}
+
+	return reset
+}
+
+func _getExpirySinkNames() string {
+
+	expirySinkMu.RLock()
+	defer expirySinkMu.RUnlock()
+
+	var names []string
+	for name := range expirySinks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,187 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"testing"
+	"time"
+)
+
+// expiryTestStore claims codes in memory, notified holds the claimed "<type>/<meta_code>" keys
+type expiryTestStore struct {
+	codes    []expiryCandidate
+	notified map[string]bool
+}
+
+// expiryTestSink records emitted events, emit fails with err if set
+type expiryTestSink struct {
+	events []expiryEvent
+	err    error
+}
+
+//
+// -- core test helper methods :: *.n
+//
+
+func (s *expiryTestStore) claim(_ context.Context, eventType expiryEventType, now time.Time, warning time.Duration) (*expiryCandidate, error) {
+
+	for i, code := range s.codes {
+		key := string(eventType) + "/" + code.MetaCode
+		expired := !code.MetaValidTo.After(now)
+		expiring := !expired && !code.MetaValidTo.After(now.Add(warning))
+		if s.notified[key] || (eventType == expiryEventExpired && !expired) || (eventType == expiryEventExpiringSoon && !expiring) {
+			continue
+		}
+		s.notified[key] = true
+		return &s.codes[i], nil
+	}
+
+	return nil, nil
+}
+
+func (s *expiryTestStore) release(_ context.Context, eventType expiryEventType, id primitive.ObjectID) error {
+
+	for _, code := range s.codes {
+		if code.ID == id {
+			delete(s.notified, string(eventType)+"/"+code.MetaCode)
+		}
+	}
+
+	return nil
+}
+
+func (s *expiryTestSink) emit(_ context.Context, event expiryEvent) error {
+
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, event)
+
+	return nil
+}
+
+func expiryTestScheduler(now time.Time, sink expirySink) (*expiryScheduler, *expiryTestStore) {
+
+	store := &expiryTestStore{notified: map[string]bool{}, codes: []expiryCandidate{
+		{ID: primitive.NewObjectID(), MetaCode: "expired", MetaValidTo: now.Add(-time.Hour)},
+		{ID: primitive.NewObjectID(), MetaCode: "expiring", MetaValidTo: now.Add(time.Hour)},
+		{ID: primitive.NewObjectID(), MetaCode: "valid", MetaValidTo: now.Add(72 * time.Hour)},
+	}}
+
+	return &expiryScheduler{store: store, sink: sink, warning: 24 * time.Hour, batch: 10, now: func() time.Time { return now }}, store
+}
+
+//
+// -- core test methods :: invite code expiry
+//
+
+func TestExpiry_Filter(t *testing.T) {
+
+	now := time.Now()
+	assert.Equal(t, bson.M{"is_deleted": false, "meta_valid_to": bson.M{"$gt": now}}, inviteCodeExpiryFilter(bson.M{"is_deleted": false}, false, now))
+	assert.Equal(t, bson.M{"is_deleted": false}, inviteCodeExpiryFilter(bson.M{"is_deleted": false}, true, now))
+}
+
+func TestExpiry_ExpiredError(t *testing.T) {
+
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	inviteCode := &UserInviteCode{ID: primitive.NewObjectID(), MetaValidTo: now.Add(time.Second)}
+	assert.NoError(t, inviteCodeExpiredError(inviteCode, now))
+
+	inviteCode.MetaValidTo = now
+	code, info := errorsTestInfo(t, inviteCodeExpiredError(inviteCode, now))
+	assert.Equal(t, codes.FailedPrecondition, code)
+	assert.Equal(t, string(reasonCodeExpired), info.GetReason())
+	assert.Equal(t, "2020-10-01T12:00:00Z", info.GetMetadata()["meta_valid_to"])
+}
+
+func TestExpiry_SchedulerEmitsOnce(t *testing.T) {
+
+	now := time.Now()
+	sink := &expiryTestSink{}
+	scheduler, _ := expiryTestScheduler(now, sink)
+
+	emitted, err := scheduler.scan(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, emitted)
+	assert.Len(t, sink.events, 2)
+	assert.Equal(t, expiryEventExpired, sink.events[0].Type)
+	assert.Equal(t, "expired", sink.events[0].MetaCode)
+	assert.Equal(t, expiryEventExpiringSoon, sink.events[1].Type)
+	assert.Equal(t, "expiring", sink.events[1].MetaCode)
+
+	// claimed codes are not emitted again
+	emitted, err = scheduler.scan(ctx)
+	assert.NoError(t, err)
+	assert.Zero(t, emitted)
+
+	// once the expiring code has expired, its expired event follows
+	scheduler.now = func() time.Time { return now.Add(2 * time.Hour) }
+	_, err = scheduler.scan(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sink.events, 3)
+	assert.Equal(t, expiryEventExpired, sink.events[2].Type)
+	assert.Equal(t, "expiring", sink.events[2].MetaCode)
+}
+
+func TestExpiry_SchedulerSinkFailure(t *testing.T) {
+
+	sink := &expiryTestSink{err: errors.New("sink down")}
+	scheduler, store := expiryTestScheduler(time.Now(), sink)
+
+	_, err := scheduler.scan(ctx)
+	assert.Error(t, err)
+	assert.Empty(t, store.notified, "claim released")
+
+	// the event is retried by the next scan
+	sink.err = nil
+	emitted, err := scheduler.scan(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, emitted)
+
+	// no expiring soon events without warning period
+	scheduler, _ = expiryTestScheduler(time.Now(), sink)
+	scheduler.warning = 0
+	emitted, _ = scheduler.scan(ctx)
+	assert.Equal(t, 1, emitted)
+}
+
+func TestExpiry_Sinks(t *testing.T) {
+
+	sink, err := newExpirySink(&Config{InviteCodeExpirySink: metaExpirySinkLog})
+	assert.NoError(t, err)
+	assert.NoError(t, sink.emit(ctx, expiryEvent{Type: expiryEventExpired}))
+
+	_, err = newExpirySink(&Config{InviteCodeExpirySink: "pigeon"})
+	assert.Error(t, err)
+
+	registerExpirySink("test", func(*Config) (expirySink, error) { return &expiryTestSink{}, nil })
+	defer func() { expirySinkMu.Lock(); delete(expirySinks, "test"); expirySinkMu.Unlock() }()
+
+	cfg := &Config{InviteCodeExpirySink: "test", InviteCodeExpiryTTL: -time.Second}
+	assert.Equal(t, []string{"INVITE_CODE_EXPIRY_TTL: must not be negative, got -1s"}, cfg._validateExpiry())
+
+	cfg.InviteCodeExpirySink = "pigeon"
+	assert.Contains(t, cfg._validateExpiry()[1], "must be one of [log, test, webhook]")
+}
@@ -0,0 +1,465 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"math/rand"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	faultDistributionFixed   = "fixed"
+	faultDistributionUniform = "uniform"
+	faultDistributionNormal  = "normal"
+)
+
+const (
+	faultKindLatency         = "latency"
+	faultKindError           = "error"
+	faultKindStreamInterrupt = "stream_interrupt"
+	faultKindMongoTimeout    = "mongo_timeout"
+)
+
+// faultDuration allows durations in rule files to be written as "250ms", "1.5s" ...
+type faultDuration time.Duration
+
+// faultRule describes a single fault, applied to a percentage of all calls matching one of the
+// method patterns (e.g. "*", "GetInviteCode" or "/aribor.UserInviteCodeService/List*").
+type faultRule struct {
+	Name                 string        `json:"name"`
+	Methods              []string      `json:"methods"`
+	Percentage           float64       `json:"percentage"`
+	Latency              *faultLatency `json:"latency,omitempty"`
+	ErrorCode            *codes.Code   `json:"error_code,omitempty"`
+	ErrorMessage         string        `json:"error_message,omitempty"`
+	StreamInterruptAfter int           `json:"stream_interrupt_after,omitempty"`
+	MongoTimeout         faultDuration `json:"mongo_timeout,omitempty"`
+}
+
+type faultLatency struct {
+	Distribution string        `json:"distribution"`
+	Fixed        faultDuration `json:"fixed,omitempty"`
+	Min          faultDuration `json:"min,omitempty"`
+	Max          faultDuration `json:"max,omitempty"`
+	Mean         faultDuration `json:"mean,omitempty"`
+	StdDev       faultDuration `json:"std_dev,omitempty"`
+}
+
+type faultRuleSet struct {
+	Enabled bool         `json:"enabled"`
+	Rules   []*faultRule `json:"rules"`
+}
+
+// faultInjector holds the active rule set and applies it to every gRPC call via interceptors.
+type faultInjector struct {
+	mu      sync.RWMutex
+	enabled bool
+	file    string
+	rules   []*faultRule
+	random  *rand.Rand
+	randMu  sync.Mutex
+}
+
+// faultInjectorState is a snapshot of the injector, e.g. for logging or runtime state reports.
+type faultInjectorState struct {
+	Enabled bool
+	File    string
+	Rules   []faultRule
+}
+
+// faultServerStream interrupts the stream after limit messages (0 = never) and passes the (fault marked) context
+type faultServerStream struct {
+	grpc.ServerStream
+	ctx         context.Context
+	limit       int
+	sent        int
+	interrupted bool
+}
+
+// faultMongoTimeoutKey marks calls whose database operations time out (mongo_timeout rules)
+type faultMongoTimeoutKey struct{}
+
+// faultDefaultRules mirrors the former SIGUSR2 behaviour (random latency up to 1750ms for every call),
+// it is used whenever no rule file has been configured.
+var faultDefaultRules = []*faultRule{{
+	Name:       "default-latency",
+	Methods:    []string{"*"},
+	Percentage: 100,
+	Latency: &faultLatency{
+		Distribution: faultDistributionUniform,
+		Max:          faultDuration(1750 * time.Millisecond),
+	},
+}}
+
+//
+// -- gRPC Fault Stack 5/n :: fault injection (resilience testing)
+//
+
+func newFaultInjector(file string) (*faultInjector, error) {
+
+	f := &faultInjector{
+		file:   file,
+		rules:  faultDefaultRules,
+		random: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+
+	if file == "" {
+		return f, nil
+	}
+
+	set, err := loadFaultRuleSet(file)
+	if err != nil {
+		return nil, err
+	}
+
+	f.rules, f.enabled = set.Rules, set.Enabled
+
+	return f, nil
+}
+
+func loadFaultRuleSet(file string) (*faultRuleSet, error) {
+
+	raw, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read fault rule file [%s]: %v", file, err)
+	}
+
+	set := &faultRuleSet{}
+	if err := json.Unmarshal(raw, set); err != nil {
+		return nil, fmt.Errorf("unable to parse fault rule file [%s]: %v", file, err)
+	}
+
+	for i, rule := range set.Rules {
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("invalid fault rule #%d [%s] in [%s]: %v", i, rule.Name, file, err)
+		}
+	}
+
+	return set, nil
+}
+
+// reload re-reads the rule file (if any), the current rules stay active if the file is broken.
+func (f *faultInjector) reload() error {
+
+	if f.file == "" {
+		return nil
+	}
+
+	set, err := loadFaultRuleSet(f.file)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	f.rules = set.Rules
+	f.mu.Unlock()
+
+	return nil
+}
+
+// toggle switches fault injection on/off, rules get reloaded from file on every activation.
+func (f *faultInjector) toggle() (bool, error) {
+
+	f.mu.RLock()
+	enabled := !f.enabled
+	f.mu.RUnlock()
+
+	var err error
+	if enabled {
+		err = f.reload()
+	}
+
+	f.setEnabled(enabled)
+
+	return enabled, err
+}
+
+func (f *faultInjector) setEnabled(enabled bool) {
+
+	f.mu.Lock()
+	f.enabled = enabled
+	f.mu.Unlock()
+}
+
+func (f *faultInjector) state() faultInjectorState {
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	state := faultInjectorState{Enabled: f.enabled, File: f.file}
+	for _, rule := range f.rules {
+		state.Rules = append(state.Rules, *rule)
+	}
+
+	return state
+}
+
+func (f *faultInjector) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	ctx, _, err := f.apply(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (f *faultInjector) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+	ctx, interrupt, err := f.apply(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	if interrupt == nil && ctx == ss.Context() {
+		return handler(srv, ss)
+	}
+
+	// handlers stop on the failed send of the interrupted stream, their error is replaced by the injected one
+	fs := &faultServerStream{ServerStream: ss, ctx: ctx}
+	if interrupt != nil {
+		fs.limit = interrupt.StreamInterruptAfter
+	}
+	err = handler(srv, fs)
+	if fs.interrupted {
+		_recordFaultInjection(ss.Context(), info.FullMethod, interrupt.Name, faultKindStreamInterrupt, 0)
+		return status.Errorf(codes.Unavailable, "stream interrupted after %d message(s) (fault injection)", fs.limit)
+	}
+
+	return err
+}
+
+// apply runs all matching rules for the given method and returns the context of the handler (marked by
+// mongo_timeout rules), the rule with the lowest stream interruption limit or the error to be sent back
+// instead of calling the actual handler.
+func (f *faultInjector) apply(ctx context.Context, method string) (context.Context, *faultRule, error) {
+
+	f.mu.RLock()
+	enabled, rules := f.enabled, f.rules
+	f.mu.RUnlock()
+
+	if !enabled || _isServiceInternalMethod(method) {
+		return ctx, nil, nil
+	}
+
+	var interrupt *faultRule
+	for _, rule := range rules {
+		if !rule.matches(method) || f._roll() >= rule.Percentage {
+			continue
+		}
+
+		if rule.Latency != nil {
+			latency := rule.Latency.sample(f)
+			_recordFaultInjection(ctx, method, rule.Name, faultKindLatency, latency)
+			if err := _sleepWithContext(ctx, latency); err != nil {
+				return ctx, nil, status.FromContextError(err).Err()
+			}
+		}
+
+		// the database operations of the call time out (see mongoDbOperationContext)
+		if rule.MongoTimeout > 0 && faultMongoTimeout(ctx) == 0 {
+			_recordFaultInjection(ctx, method, rule.Name, faultKindMongoTimeout, time.Duration(rule.MongoTimeout))
+			ctx = context.WithValue(ctx, faultMongoTimeoutKey{}, time.Duration(rule.MongoTimeout))
+		}
+
+		if rule.ErrorCode != nil {
+			_recordFaultInjection(ctx, method, rule.Name, faultKindError, 0)
+			msg := rule.ErrorMessage
+			if msg == "" {
+				msg = fmt.Sprintf("fault injection [%s]", rule.Name)
+			}
+			return ctx, nil, status.Error(*rule.ErrorCode, msg)
+		}
+
+		if rule.StreamInterruptAfter > 0 && (interrupt == nil || rule.StreamInterruptAfter < interrupt.StreamInterruptAfter) {
+			interrupt = rule
+		}
+	}
+
+	return ctx, interrupt, nil
+}
+
+// faultMongoTimeout returns the database timeout injected into the call of ctx (0 = none)
+func faultMongoTimeout(ctx context.Context) time.Duration {
+
+	timeout, _ := ctx.Value(faultMongoTimeoutKey{}).(time.Duration)
+
+	return timeout
+}
+
+func (r *faultRule) validate() error {
+
+	var errs []string
+	if len(r.Methods) == 0 {
+		errs = append(errs, "no method matcher defined")
+	}
+
+	for _, m := range r.Methods {
+		if _, err := path.Match(m, ""); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid method matcher [%s]", m))
+		}
+	}
+
+	if r.Percentage <= 0 || r.Percentage > 100 {
+		errs = append(errs, fmt.Sprintf("percentage must be within (0,100], got %v", r.Percentage))
+	}
+
+	if r.Latency == nil && r.ErrorCode == nil && r.StreamInterruptAfter <= 0 && r.MongoTimeout <= 0 {
+		errs = append(errs, "rule defines no fault at all")
+	}
+
+	if r.ErrorCode != nil && *r.ErrorCode == codes.OK {
+		errs = append(errs, "error_code OK can't be injected")
+	}
+
+	if r.Latency != nil {
+		switch r.Latency.Distribution {
+		case faultDistributionFixed, faultDistributionNormal:
+		case faultDistributionUniform:
+			if r.Latency.Max < r.Latency.Min {
+				errs = append(errs, "uniform latency max is lower than min")
+			}
+		default:
+			errs = append(errs, fmt.Sprintf("unknown latency distribution [%s]", r.Latency.Distribution))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func (r *faultRule) matches(fullMethod string) bool {
+
+	shortMethod := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, pattern := range r.Methods {
+		if ok, _ := path.Match(pattern, fullMethod); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, shortMethod); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (l *faultLatency) sample(f *faultInjector) time.Duration {
+
+	f.randMu.Lock()
+	defer f.randMu.Unlock()
+
+	var d time.Duration
+	switch l.Distribution {
+	case faultDistributionFixed:
+		d = time.Duration(l.Fixed)
+	case faultDistributionUniform:
+		d = time.Duration(l.Min)
+		if spread := int64(l.Max - l.Min); spread > 0 {
+			d += time.Duration(f.random.Int63n(spread))
+		}
+	case faultDistributionNormal:
+		d = time.Duration(float64(l.Mean) + f.random.NormFloat64()*float64(l.StdDev))
+	}
+
+	if d < 0 {
+		return 0
+	}
+
+	return d
+}
+
+func (s *faultServerStream) SendMsg(m interface{}) error {
+
+	// swallow all messages after the limit, the interceptor turns the stream result into an error
+	if s.limit > 0 && s.sent >= s.limit {
+		s.interrupted = true
+		return status.Errorf(codes.Unavailable, "stream interrupted (fault injection)")
+	}
+
+	s.sent++
+
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *faultServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (d *faultDuration) UnmarshalJSON(b []byte) error {
+
+	var raw string
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return fmt.Errorf("duration must be a string like \"250ms\": %v", err)
+	}
+
+	parsed, err := time.ParseDuration(raw)
+	if err != nil {
+		return err
+	}
+
+	*d = faultDuration(parsed)
+
+	return nil
+}
+
+func (d faultDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+//
+// -- sidekick stack for fault injection helper methods
+//
+
+func (f *faultInjector) _roll() float64 {
+
+	f.randMu.Lock()
+	defer f.randMu.Unlock()
+
+	return f.random.Float64() * 100
+}
+
+func _sleepWithContext(ctx context.Context, d time.Duration) error {
+
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
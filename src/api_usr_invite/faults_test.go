@@ -0,0 +1,227 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+)
+
+const faultTestRuleFile = `{
+  "enabled": true,
+  "rules": [
+    { "name": "slow-get", "methods": ["GetVersion"], "percentage": 100, "latency": { "distribution": "fixed", "fixed": "20ms" } },
+    { "name": "broken-get", "methods": ["/aribor.UserInviteCodeService/GetVersion"], "percentage": 100, "error_code": "RESOURCE_EXHAUSTED" },
+    { "name": "cut-lists", "methods": ["List*"], "percentage": 100, "stream_interrupt_after": 2 }
+  ]
+}`
+
+//
+// -- core test helper methods :: *.n
+//
+
+func faultTestInjector(t *testing.T, content string) *faultInjector {
+
+	file, err := ioutil.TempFile("", "faults-*.json")
+	if err != nil { t.Fatal(err) }
+	defer os.Remove(file.Name())
+
+	_, _ = file.WriteString(content); _ = file.Close()
+
+	f, err := newFaultInjector(file.Name())
+	if err != nil { t.Fatal(err) }
+
+	return f
+}
+
+func faultTestDialer(f *faultInjector) func(context.Context, string) (net.Conn, error) {
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(f.unaryInterceptor), grpc.ChainStreamInterceptor(f.streamInterceptor))
+	rfpb.RegisterUserInviteCodeServiceServer(server, &UserInviteCodeServiceServer{})
+	go func() { _ = server.Serve(listener) }()
+
+	return func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}
+}
+
+type faultTestStream struct {
+	grpc.ServerStream
+	received int
+}
+
+func (s *faultTestStream) Context() context.Context { return ctx }
+func (s *faultTestStream) SendMsg(_ interface{}) error { s.received++; return nil }
+
+//
+// -- core test methods :: fault injection
+//
+
+func TestFaultInjector_LoadRuleFile(t *testing.T) {
+
+	f := faultTestInjector(t, faultTestRuleFile)
+	state := f.state()
+
+	assert.True(t, state.Enabled)
+	assert.Len(t, state.Rules, 3)
+	assert.Equal(t, codes.ResourceExhausted, *state.Rules[1].ErrorCode)
+	assert.Equal(t, 20*time.Millisecond, time.Duration(state.Rules[0].Latency.Fixed))
+}
+
+func TestFaultInjector_InvalidRuleFile(t *testing.T) {
+
+	file, _ := ioutil.TempFile("", "faults-*.json")
+	defer os.Remove(file.Name())
+	_, _ = file.WriteString(`{"rules":[{"name":"bad","methods":["*"],"percentage":120,"latency":{"distribution":"pareto"}}]}`)
+	_ = file.Close()
+
+	_, err := newFaultInjector(file.Name())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "percentage must be within")
+	assert.Contains(t, err.Error(), "unknown latency distribution [pareto]")
+}
+
+func TestFaultInjector_DefaultRulesAndToggle(t *testing.T) {
+
+	f, err := newFaultInjector("")
+	if err != nil { t.Fatal(err) }
+
+	assert.False(t, f.state().Enabled)
+	assert.Equal(t, "default-latency", f.state().Rules[0].Name)
+
+	enabled, err := f.toggle()
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	enabled, _ = f.toggle()
+	assert.False(t, enabled)
+}
+
+func TestFaultInjector_RuleMatching(t *testing.T) {
+
+	rule := &faultRule{Methods: []string{"List*", "/aribor.UserInviteCodeService/GetInviteCode"}}
+
+	assert.True(t, rule.matches("/aribor.UserInviteCodeService/ListInviteCodes"))
+	assert.True(t, rule.matches("/aribor.UserInviteCodeService/ListFilteredInviteCodes"))
+	assert.True(t, rule.matches("/aribor.UserInviteCodeService/GetInviteCode"))
+	assert.False(t, rule.matches("/aribor.UserInviteCodeService/GetVersion"))
+}
+
+func TestFaultInjector_LatencyDistributions(t *testing.T) {
+
+	f, _ := newFaultInjector("")
+
+	fixed := &faultLatency{Distribution: faultDistributionFixed, Fixed: faultDuration(time.Second)}
+	assert.Equal(t, time.Second, fixed.sample(f))
+
+	uniform := &faultLatency{Distribution: faultDistributionUniform, Min: faultDuration(10 * time.Millisecond), Max: faultDuration(20 * time.Millisecond)}
+	normal := &faultLatency{Distribution: faultDistributionNormal, Mean: faultDuration(5 * time.Millisecond), StdDev: faultDuration(50 * time.Millisecond)}
+	for i := 0; i < 100; i++ {
+		d := uniform.sample(f)
+		assert.True(t, d >= 10*time.Millisecond && d < 20*time.Millisecond)
+		assert.True(t, normal.sample(f) >= 0)
+	}
+}
+
+func TestFaultInjector_UnaryErrorAndLatency(t *testing.T) {
+
+	f := faultTestInjector(t, faultTestRuleFile)
+
+	conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(faultTestDialer(f)))
+	if err != nil { t.Fatal(err) }; defer conn.Close()
+
+	client := rfpb.NewUserInviteCodeServiceClient(conn)
+
+	started := time.Now()
+	_, err = client.GetVersion(ctx, &rfpb.VersionReq{})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.True(t, time.Since(started) >= 20*time.Millisecond)
+
+	f.setEnabled(false)
+	_, err = client.GetVersion(ctx, &rfpb.VersionReq{})
+	assert.NoError(t, err)
+}
+
+func TestFaultInjector_StreamInterruption(t *testing.T) {
+
+	f := faultTestInjector(t, faultTestRuleFile)
+	stream := &faultTestStream{}
+	info := &grpc.StreamServerInfo{FullMethod: "/aribor.UserInviteCodeService/ListInviteCodes", IsServerStream: true}
+
+	// like the list handlers, the handler stops on the first failed send
+	err := f.streamInterceptor(nil, stream, info, func(_ interface{}, ss grpc.ServerStream) error {
+		for i := 0; i < 5; i++ {
+			if err := ss.SendMsg(&rfpb.ListInviteCodeRes{}); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send data, client gone")
+			}
+		}
+		return nil
+	})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "stream interrupted after 2 message(s)")
+	assert.Equal(t, 2, stream.received)
+
+	// handler errors of streams which were not interrupted are passed through
+	err = f.streamInterceptor(nil, &faultTestStream{}, info, func(_ interface{}, _ grpc.ServerStream) error {
+		return status.Errorf(codes.NotFound, "not found")
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestFaultInjector_MongoTimeout(t *testing.T) {
+
+	f := faultTestInjector(t, `{"enabled": true, "rules": [{"name": "mongo-down", "methods": ["*InviteCode*"], "percentage": 100, "mongo_timeout": "20ms"}]}`)
+
+	// the database operation of the handler times out, it is mapped like a real timeout
+	started := time.Now()
+	_, err := f.unaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/aribor.UserInviteCodeService/GetInviteCode"}, func(handlerCtx context.Context, _ interface{}) (interface{}, error) {
+		opCtx, cancel := mongoDbOperationContext(handlerCtx, time.Minute)
+		defer cancel()
+		return nil, mongoDbDomainError(opCtx, opCtx.Err(), "")
+	})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.True(t, time.Since(started) >= 20*time.Millisecond)
+
+	// streams pass the marked context, the stream itself is not interrupted
+	stream := &faultTestStream{}
+	err = f.streamInterceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/aribor.UserInviteCodeService/ListInviteCodes"}, func(_ interface{}, ss grpc.ServerStream) error {
+		assert.Equal(t, 20*time.Millisecond, faultMongoTimeout(ss.Context()))
+		return ss.SendMsg(&rfpb.ListInviteCodeRes{})
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stream.received)
+
+	// other methods are not affected
+	_, err = f.unaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/aribor.UserInviteCodeService/GetVersion"}, func(handlerCtx context.Context, _ interface{}) (interface{}, error) {
+		assert.Zero(t, faultMongoTimeout(handlerCtx))
+		return nil, nil
+	})
+	assert.NoError(t, err)
+}
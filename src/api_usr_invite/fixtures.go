@@ -0,0 +1,285 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// max. number of (distinct) error messages kept per role in a fixture seed report
+const metaFixtureMaxRoleErrors = 5
+
+var (
+	errFixtureSeedInProgress = errors.New("fixture seeding already in progress")
+	errFixtureSeedProtected  = errors.New("fixture seeding refused, environment is protected")
+)
+
+// fixtureSeedReport is the structured result of a (partial) fixture seeding run, seeding never stops the
+// service, all failures are collected per role (insert errors) or globally (index/cleanup errors).
+type fixtureSeedReport struct {
+	Profile    string
+	Seed       int64
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Roles      []*fixtureRoleReport
+	Errors     []string
+}
+
+type fixtureRoleReport struct {
+	Role      string
+	Requested int
+	Created   int
+	Failed    int
+	Errors    []string
+}
+
+// fixtureSeedInProgress guards against concurrent seeding runs (e.g. two quick USR1 signals)
+var fixtureSeedInProgress int32
+
+// fixtureProtectedEnvironments lists all ENVIRONMENT values fixture seeding is refused for
+var fixtureProtectedEnvironments = []string{"production"}
+
+//
+// -- gRPC MongoDb Stack 3/n :: MongoDbOps (fixtures)
+//
+
+// mongoDbFixtureSeed applies pending schema migrations and loads all invite code fixtures of the given
+// profile, a second call while seeding is still running is rejected with errFixtureSeedInProgress,
+// any call within a protected environment (e.g. ENVIRONMENT=production) with errFixtureSeedProtected.
+func mongoDbFixtureSeed(profile *fixtureProfile) (*fixtureSeedReport, error) {
+
+	if _isFixtureEnvironmentProtected(configCurrent().Environment) {
+		log.Warnf("%s: mongodb: fixture seeding refused in protected environment [%s] <skip>",metaServiceName,configCurrent().Environment)
+		return nil, errFixtureSeedProtected
+	}
+
+	if !atomic.CompareAndSwapInt32(&fixtureSeedInProgress, 0, 1) {
+		log.Warnf("%s: mongodb: fixture seeding already in progress <skip>",metaServiceName)
+		return nil, errFixtureSeedInProgress
+	};  defer atomic.StoreInt32(&fixtureSeedInProgress, 0)
+
+	log.Infof("%s: mongodb: fixture seeding [%s] started (fixtures: %d)",metaServiceName,profile.Name,profile.total())
+	report := &fixtureSeedReport{Profile: profile.Name, StartedAt: time.Now()}
+	if err := mongoDbMigrate(); err != nil {
+		log.Warnf("%s: mongodb: %v",metaServiceName,err)
+		report.Errors = append(report.Errors, fmt.Sprintf("migrate: %v", err))
+	}
+
+	mongoDbFixtureLoadInviteCodes(profile, report)
+	report.FinishedAt = time.Now()
+
+	log.Infof("%s: mongodb: fixture seeding [%s] finished (seed: %d, created: %d, failed: %d, errors: %d)",metaServiceName,report.Profile,report.Seed,report.created(),report.failed(),len(report.Errors))
+
+	return report, nil
+}
+
+// mongoDbFixtureClean removes fixture documents only (optionally test documents as well), real
+// invite codes are never touched.
+func mongoDbFixtureClean(includeTest bool) error {
+
+	opCtx, cancel := mongoDbOperationContext(metaMongoDbContext, configCurrent().MongoDbOperationTimeout)
+	defer cancel()
+
+	collection := mongoDbCurrent().collection
+	dr, err := collection.DeleteMany(opCtx, _getFixtureCleanFilter(includeTest))
+
+	if err != nil { return err }
+	if dr.DeletedCount > 0 {
+		log.Infof("%s: mongodb: delete %v documents in [%s] collection",metaServiceName,dr.DeletedCount,collection.Name())
+	}
+
+	return nil
+}
+
+func mongoDbFixtureLoadInviteCodes(profile *fixtureProfile, report *fixtureSeedReport) {
+
+	if profile.Wipe {
+		if err := mongoDbFixtureClean(profile.WipeTest); err != nil {
+			log.Warnf("%s: mongodb: unable to clean fixtures, skip loading: %v",metaServiceName,err)
+			report.Errors = append(report.Errors, fmt.Sprintf("clean fixtures: %v", err))
+			return
+		}
+	}
+
+	generator := newFixtureGenerator(profile, time.Now())
+	report.Seed = generator.seed
+
+	// -- load [user_invitation_codes] collection for every fixture role --
+	for _, fixture := range profile.Roles {
+		log.Infof("%s: mongodb: generate [%s] fixtures in collection [%s]",metaServiceName,fixture.Role,metaMongoDbCollectionTbl)
+		roleReport := &fixtureRoleReport{Role: fixture.Role, Requested: fixture.Count}
+		report.Roles = append(report.Roles, roleReport)
+
+		// fixtures are bound to INVITE_CODE_ROLES like created codes, checked per run as the roles may be reloaded
+		if err := _getFixtureRoleError(fixture.Role); err != nil {
+			log.Warnf("%s: mongodb: skip [%s] fixtures: %v",metaServiceName,fixture.Role,err)
+			roleReport.addError(err)
+			roleReport.Failed = fixture.Count
+			continue
+		}
+
+		for seq := 1; seq <= fixture.Count; seq++ {
+			inviteCode, err := generator.next(fixture, seq)
+			if err == nil {
+				err = _upsertFixtureInviteCode(inviteCode)
+			}
+			if err != nil {
+				roleReport.addError(err)
+				continue
+			}
+			roleReport.Created++
+		}
+	}
+}
+
+func (r *fixtureSeedReport) created() int {
+
+	created := 0
+	for _, role := range r.Roles {
+		created += role.Created
+	}
+
+	return created
+}
+
+func (r *fixtureSeedReport) failed() int {
+
+	failed := 0
+	for _, role := range r.Roles {
+		failed += role.Failed
+	}
+
+	return failed
+}
+
+// success reports a seeding run without any error, partial a run with errors but (some) created fixtures
+func (r *fixtureSeedReport) success() bool {
+	return len(r.Errors) == 0 && r.failed() == 0
+}
+
+func (r *fixtureSeedReport) partial() bool {
+	return !r.success() && r.created() > 0
+}
+
+func (r *fixtureSeedReport) summary() string {
+
+	if r.success() {
+		return fmt.Sprintf("%d fixture(s) created", r.created())
+	}
+
+	msg := fmt.Sprintf("%d fixture(s) created, %d failed", r.created(), r.failed())
+	if len(r.Errors) > 0 {
+		msg = fmt.Sprintf("%s (%s)", msg, strings.Join(r.Errors, "; "))
+	}
+
+	return msg
+}
+
+func (r *fixtureRoleReport) addError(err error) {
+
+	r.Failed++
+	if len(r.Errors) >= metaFixtureMaxRoleErrors {
+		return
+	}
+
+	for _, known := range r.Errors {
+		if known == err.Error() {
+			return
+		}
+	}
+
+	r.Errors = append(r.Errors, err.Error())
+}
+
+//
+// -- sidekick stack for MongoDbOps (fixtures)
+//
+
+// _upsertFixtureInviteCode inserts or replaces a fixture keyed on meta_code, a real (non fixture) code
+// with the same meta_code is never replaced, the upsert fails with a duplicate key error instead.
+func _upsertFixtureInviteCode(inviteCode UserInviteCode) error {
+
+	update, err := _getFixtureUpsertUpdate(inviteCode)
+	if err != nil { return err }
+
+	opCtx, cancel := mongoDbOperationContext(metaMongoDbContext, configCurrent().MongoDbOperationTimeout)
+	defer cancel()
+
+	filter := bson.M{"meta_code": inviteCode.MetaCode, "is_fixture": true}
+	_, err = mongoDbCurrent().collection.UpdateOne(opCtx, filter, update, options.Update().SetUpsert(true)); if err != nil {
+		log.Infof("%s: mongodb: upsert code [%s]-[%s] failed, code may be used by a non fixture document ...",metaServiceName,inviteCode.MetaCode,inviteCode.MetaForAppRole)
+		return err
+	}
+
+	log.Infof("%s: mongodb: upsert code [%s]-[%s]",metaServiceName,inviteCode.MetaCode,inviteCode.MetaForAppRole)
+
+	return nil
+}
+
+func _getFixtureRoleError(role string) error {
+
+	if !_isKnownRole(role) {
+		return fmt.Errorf("unknown role [%s], known roles: %s", role, strings.Join(configCurrent().inviteCodeRoles(), ", "))
+	}
+
+	return nil
+}
+
+// _getFixtureUpsertUpdate sets all fields of the fixture, the _id is only set on insert (immutable)
+func _getFixtureUpsertUpdate(inviteCode UserInviteCode) (bson.M, error) {
+
+	raw, err := bson.Marshal(inviteCode)
+	if err != nil { return nil, err }
+
+	fields := bson.M{}
+	if err := bson.Unmarshal(raw, &fields); err != nil { return nil, err }
+	delete(fields, "_id")
+
+	update := bson.M{"$set": fields}
+	if !inviteCode.ID.IsZero() {
+		update["$setOnInsert"] = bson.M{"_id": inviteCode.ID}
+	}
+
+	return update, nil
+}
+
+func _getFixtureCleanFilter(includeTest bool) bson.M {
+
+	if includeTest {
+		return bson.M{"$or": bson.A{bson.M{"is_fixture": true}, bson.M{"is_test": true}}}
+	}
+
+	return bson.M{"is_fixture": true}
+}
+
+func _isFixtureEnvironmentProtected(environment string) bool {
+
+	for _, protected := range fixtureProtectedEnvironments {
+		if strings.EqualFold(strings.TrimSpace(environment), protected) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,160 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+//
+// -- core test methods :: fixture seeding
+//
+
+func TestFixtureSeed_InProgressGuard(t *testing.T) {
+
+	atomic.StoreInt32(&fixtureSeedInProgress, 1)
+	defer atomic.StoreInt32(&fixtureSeedInProgress, 0)
+
+	report, err := mongoDbFixtureSeed(fixtureDefaultProfile)
+	assert.Nil(t, report)
+	assert.Equal(t, errFixtureSeedInProgress, err)
+}
+
+func TestFixtureSeed_ProtectedEnvironment(t *testing.T) {
+
+	environment := configCurrent().Environment
+	defer func() { configCurrent().Environment = environment }()
+
+	configCurrent().Environment = "Production"
+	report, err := mongoDbFixtureSeed(fixtureDefaultProfile)
+	assert.Nil(t, report)
+	assert.Equal(t, errFixtureSeedProtected, err)
+
+	assert.False(t, _isFixtureEnvironmentProtected(""))
+	assert.False(t, _isFixtureEnvironmentProtected("staging"))
+}
+
+func TestFixtureSeed_CleanFilter(t *testing.T) {
+
+	assert.Equal(t, bson.M{"is_fixture": true}, _getFixtureCleanFilter(false))
+	assert.Equal(t, bson.M{"$or": bson.A{bson.M{"is_fixture": true}, bson.M{"is_test": true}}}, _getFixtureCleanFilter(true))
+}
+
+func TestFixtureSeed_UpsertUpdate(t *testing.T) {
+
+	code := UserInviteCode{ID: primitive.NewObjectID(), MetaCode: "E2E-admin-1", MetaForAppRole: "admin", IsFixture: true}
+
+	update, err := _getFixtureUpsertUpdate(code)
+	if err != nil { t.Fatal(err) }
+
+	fields := update["$set"].(bson.M)
+	assert.Equal(t, "E2E-admin-1", fields["meta_code"])
+	assert.Equal(t, true, fields["is_fixture"])
+	assert.NotContains(t, fields, "_id")
+	assert.Equal(t, bson.M{"_id": code.ID}, update["$setOnInsert"])
+
+	update, _ = _getFixtureUpsertUpdate(UserInviteCode{MetaCode: "01E9QW1DG044Z2V3VPJE8H0GBN"})
+	assert.NotContains(t, update, "$setOnInsert")
+}
+
+func TestFixtureSeedReport_Aggregation(t *testing.T) {
+
+	admin := &fixtureRoleReport{Role: "admin", Requested: 10, Created: 10}
+	viewer := &fixtureRoleReport{Role: "viewer", Requested: 5, Created: 3}
+	viewer.addError(errors.New("duplicate key"))
+	viewer.addError(errors.New("duplicate key"))
+
+	report := &fixtureSeedReport{Roles: []*fixtureRoleReport{admin}}
+	assert.True(t, report.success())
+	assert.False(t, report.partial())
+	assert.Equal(t, "10 fixture(s) created", report.summary())
+
+	report.Roles = append(report.Roles, viewer)
+	assert.False(t, report.success())
+	assert.True(t, report.partial())
+	assert.Equal(t, 13, report.created())
+	assert.Equal(t, 2, report.failed())
+	assert.Equal(t, []string{"duplicate key"}, viewer.Errors)
+
+	report = &fixtureSeedReport{Errors: []string{"clean fixtures: connection refused"}}
+	assert.False(t, report.success())
+	assert.False(t, report.partial())
+	assert.Contains(t, report.summary(), "connection refused")
+}
+
+func TestFixtureRoleReport_ErrorLimit(t *testing.T) {
+
+	role := &fixtureRoleReport{Role: "teacher", Requested: 99}
+	for i := 0; i < 20; i++ {
+		role.addError(fmt.Errorf("error #%d", i))
+	}
+
+	assert.Equal(t, 20, role.Failed)
+	assert.Len(t, role.Errors, metaFixtureMaxRoleErrors)
+}
+
+func TestFixtureSeed_UnknownRole(t *testing.T) {
+
+	roles := configCurrent().InviteCodeRoles
+	defer func() { configCurrent().InviteCodeRoles = roles }()
+	configCurrent().InviteCodeRoles = "admin,teacher"
+
+	assert.NoError(t, _getFixtureRoleError("admin"))
+	assert.EqualError(t, _getFixtureRoleError("viewer"), "unknown role [viewer], known roles: admin, teacher")
+
+	// roles outside INVITE_CODE_ROLES are reported as failed without touching the database
+	report := &fixtureSeedReport{}
+	mongoDbFixtureLoadInviteCodes(&fixtureProfile{CodeFormat: "{ulid}", Validity: fixtureValidity{Duration: time.Hour},
+		Roles: []fixtureRoleProfile{{Role: "viewer", Count: 3}, {Role: "ghost", Count: 0}}}, report)
+
+	assert.Len(t, report.Roles, 2)
+	assert.Equal(t, 3, report.Roles[0].Failed)
+	assert.Equal(t, 0, report.Roles[0].Created)
+	assert.Equal(t, []string{"unknown role [viewer], known roles: admin, teacher"}, report.Roles[0].Errors)
+	assert.False(t, report.success())
+	assert.False(t, report.partial())
+}
+
+func TestFixtureSeed_OperationTimeout(t *testing.T) {
+
+	cfg := &Config{MongoDbPDB: "db", MongoDbLnk: "mongodb://localhost:1/", MongoDbServerSelectionTimeout: 5 * time.Second}
+	client, err := mongo.Connect(ctx, mongoDbClientOptions(cfg))
+	if err != nil { t.Fatal(err) }
+	defer client.Disconnect(ctx)
+
+	defer metaMongoDb.Store(mongoDbCurrent())
+	mongoDbSwapConnection(newMongoDbConnection(client, cfg))
+
+	timeout := configCurrent().MongoDbOperationTimeout
+	defer func() { configCurrent().MongoDbOperationTimeout = timeout }()
+	configCurrent().MongoDbOperationTimeout = 100 * time.Millisecond
+
+	// clean and upsert are capped by DB_MONGO_OPERATION_TIMEOUT (instead of the 5s server selection timeout)
+	started := time.Now()
+	assert.Error(t, mongoDbFixtureClean(false))
+	assert.Error(t, _upsertFixtureInviteCode(UserInviteCode{MetaCode: "E2E-admin-1", MetaForAppRole: "admin", IsFixture: true}))
+	assert.True(t, time.Since(started) < 5*time.Second)
+}
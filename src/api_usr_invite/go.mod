@@ -12,6 +12,9 @@ require (
 	github.com/stretchr/testify v1.6.1
 	go.mongodb.org/mongo-driver v1.4.2
 	go.opencensus.io v0.22.5
+	golang.org/x/net v0.0.0-20201026091529-146b70c837a4
+	google.golang.org/genproto v0.0.0-20201030142918-24207fddd1c3
 	google.golang.org/grpc v1.33.1
 	google.golang.org/protobuf v1.25.0
+	gopkg.in/yaml.v2 v2.4.0
 )
@@ -0,0 +1,130 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+// listTestCursor serves a fixed number of invite codes, the decode target must be a fresh struct
+type listTestCursor struct {
+	t       *testing.T
+	total   int
+	fetched int
+}
+
+//
+// -- core test helper methods :: *.n
+//
+
+func (c *listTestCursor) Next(_ context.Context) bool {
+
+	if c.fetched >= c.total {
+		return false
+	}
+	c.fetched++
+
+	return true
+}
+
+func (c *listTestCursor) Decode(val interface{}) error {
+
+	data := val.(*UserInviteCode)
+	assert.Equal(c.t, UserInviteCode{}, *data)
+
+	data.ID, data.MetaCode = primitive.NewObjectID(), fmt.Sprintf("code-%d", c.fetched)
+
+	return nil
+}
+
+func (c *listTestCursor) Err() error {
+	return nil
+}
+
+//
+// -- core test methods :: list streams
+//
+
+func TestListStream_Complete(t *testing.T) {
+
+	var received []string
+	sent, truncated, err := _sendInviteCodes(ctx, &listTestCursor{t: t, total: 3}, 10, func(code *rfpb.UserInviteCode) error {
+		received = append(received, code.GetMetaCode())
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, sent)
+	assert.False(t, truncated)
+	assert.Equal(t, []string{"code-1", "code-2", "code-3"}, received)
+}
+
+func TestListStream_Truncated(t *testing.T) {
+
+	sent, truncated, err := _sendInviteCodes(ctx, &listTestCursor{t: t, total: 5}, 2, func(*rfpb.UserInviteCode) error { return nil })
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.True(t, truncated)
+
+	// 0 = unlimited
+	sent, truncated, _ = _sendInviteCodes(ctx, &listTestCursor{t: t, total: 5}, 0, func(*rfpb.UserInviteCode) error { return nil })
+	assert.Equal(t, 5, sent)
+	assert.False(t, truncated)
+}
+
+func TestListStream_SendError(t *testing.T) {
+
+	cursor := &listTestCursor{t: t, total: 100}
+	sent, _, err := _sendInviteCodes(ctx, cursor, 0, func(code *rfpb.UserInviteCode) error {
+		if code.GetMetaCode() == "code-3" {
+			return errors.New("transport is closing")
+		}
+		return nil
+	})
+
+	// the cursor is not iterated any further once the client is gone
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 2, sent)
+	assert.Equal(t, 3, cursor.fetched)
+}
+
+func TestListStream_Canceled(t *testing.T) {
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cursor := &listTestCursor{t: t, total: 100}
+	sent, _, err := _sendInviteCodes(cancelCtx, cursor, 0, func(*rfpb.UserInviteCode) error {
+		cancel()
+		return nil
+	})
+
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Equal(t, 1, sent)
+
+	md := _getListTrailer(sent, false, true)
+	assert.Equal(t, []string{"1"}, md.Get(metaListTrailerCount))
+	assert.Equal(t, []string{"true"}, md.Get(metaListTrailerPartial))
+}
@@ -0,0 +1,110 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+//
+// -- gRPC Mapper Stack 14/n :: UserInviteCode <-> rfpb.UserInviteCode
+//
+
+// inviteCodeToProto maps a stored invite code to its protobuf representation, all fields are mapped,
+// unset timestamps (zero time) become nil and a zero object id becomes an empty id.
+func inviteCodeToProto(inviteCode *UserInviteCode) *rfpb.UserInviteCode {
+
+	if inviteCode == nil {
+		return nil
+	}
+
+	res := &rfpb.UserInviteCode{
+		MetaCode:       inviteCode.MetaCode,
+		MetaForAppRole: inviteCode.MetaForAppRole,
+		IsFixture:      inviteCode.IsFixture,
+		IsDeleted:      inviteCode.IsDeleted,
+		IsTest:         inviteCode.IsTest,
+		MetaValidFrom:  _getProtoTimestamp(inviteCode.MetaValidFrom),
+		MetaValidTo:    _getProtoTimestamp(inviteCode.MetaValidTo),
+		CreatedAt:      _getProtoTimestamp(inviteCode.CreatedAt),
+		DeletedAt:      _getProtoTimestamp(inviteCode.DeletedAt),
+		UpdatedAt:      _getProtoTimestamp(inviteCode.UpdatedAt),
+		Version:        inviteCode.Version,
+	}
+
+	if !inviteCode.ID.IsZero() {
+		res.Id = inviteCode.ID.Hex()
+	}
+
+	return res
+}
+
+// inviteCodeFromProto is the inverse of inviteCodeToProto, an id which is not a valid object id is rejected.
+// Server controlled fields (id, created/updated/deleted_at, version) are mapped as well, handlers decide what to keep.
+func inviteCodeFromProto(inviteCode *rfpb.UserInviteCode) (*UserInviteCode, error) {
+
+	res := &UserInviteCode{
+		MetaCode:       inviteCode.GetMetaCode(),
+		MetaForAppRole: inviteCode.GetMetaForAppRole(),
+		IsFixture:      inviteCode.GetIsFixture(),
+		IsDeleted:      inviteCode.GetIsDeleted(),
+		IsTest:         inviteCode.GetIsTest(),
+		MetaValidFrom:  _getTimeFromProto(inviteCode.GetMetaValidFrom()),
+		MetaValidTo:    _getTimeFromProto(inviteCode.GetMetaValidTo()),
+		CreatedAt:      _getTimeFromProto(inviteCode.GetCreatedAt()),
+		DeletedAt:      _getTimeFromProto(inviteCode.GetDeletedAt()),
+		UpdatedAt:      _getTimeFromProto(inviteCode.GetUpdatedAt()),
+		Version:        inviteCode.GetVersion(),
+	}
+
+	if inviteCode.GetId() != "" {
+		oid, err := primitive.ObjectIDFromHex(inviteCode.GetId())
+		if err != nil {
+			return nil, fmt.Errorf("invalid invite code id [%s]: %v", inviteCode.GetId(), err)
+		}
+		res.ID = oid
+	}
+
+	return res, nil
+}
+
+//
+// -- sidekick stack for mapper helper methods
+//
+
+func _getProtoTimestamp(t time.Time) *timestamppb.Timestamp {
+
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+func _getTimeFromProto(ts *timestamppb.Timestamp) time.Time {
+
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
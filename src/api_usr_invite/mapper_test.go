@@ -0,0 +1,160 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/proto"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const mapperTestRuns = 1000
+
+//
+// -- core test helper methods :: *.n
+//
+
+// mapperTestInviteCode generates a random invite code, every field (incl. timestamps) may be unset
+func mapperTestInviteCode(r *rand.Rand) *UserInviteCode {
+
+	randomTime := func() time.Time {
+		if r.Intn(4) == 0 {
+			return time.Time{}
+		}
+		return time.Unix(r.Int63n(1<<34)-(1<<33), r.Int63n(int64(time.Second))).UTC()
+	}
+
+	randomString := func() string {
+		b := make([]rune, r.Intn(16))
+		for i := range b {
+			b[i] = rune(r.Intn(0x7ff) + 1)
+		}
+		return string(b)
+	}
+
+	inviteCode := &UserInviteCode{
+		MetaCode:       randomString(),
+		MetaForAppRole: randomString(),
+		MetaValidFrom:  randomTime(),
+		MetaValidTo:    randomTime(),
+		CreatedAt:      randomTime(),
+		IsFixture:      r.Intn(2) == 0,
+		IsDeleted:      r.Intn(2) == 0,
+		IsTest:         r.Intn(2) == 0,
+		DeletedAt:      randomTime(),
+		UpdatedAt:      randomTime(),
+		Version:        r.Int63n(3),
+	}
+
+	if r.Intn(4) != 0 {
+		_, _ = r.Read(inviteCode.ID[:])
+	}
+
+	return inviteCode
+}
+
+func mapperTestTruncate(inviteCode *UserInviteCode) *UserInviteCode {
+
+	truncated := *inviteCode
+	for _, t := range []*time.Time{&truncated.MetaValidFrom, &truncated.MetaValidTo, &truncated.CreatedAt, &truncated.DeletedAt, &truncated.UpdatedAt} {
+		*t = t.Truncate(time.Millisecond)
+	}
+
+	return &truncated
+}
+
+//
+// -- core test methods :: protobuf mapping
+//
+
+func TestMapper_CoversAllFields(t *testing.T) {
+
+	// every proto field must be set by inviteCodeToProto for a fully populated invite code
+	r := rand.New(rand.NewSource(1))
+	inviteCode := mapperTestInviteCode(r)
+	inviteCode.MetaCode, inviteCode.MetaForAppRole, inviteCode.IsFixture, inviteCode.IsDeleted, inviteCode.IsTest = "code", "admin", true, true, true
+	inviteCode.Version = 7
+	for _, ts := range []*time.Time{&inviteCode.MetaValidFrom, &inviteCode.MetaValidTo, &inviteCode.CreatedAt, &inviteCode.DeletedAt, &inviteCode.UpdatedAt} {
+		*ts = time.Now().UTC()
+	}
+	_, _ = r.Read(inviteCode.ID[:])
+
+	res := inviteCodeToProto(inviteCode).ProtoReflect()
+	fields := res.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		assert.True(t, res.Has(fields.Get(i)), "unmapped field %s", fields.Get(i).Name())
+	}
+
+	// ... and every struct field must be read back
+	back, err := inviteCodeFromProto(inviteCodeToProto(inviteCode))
+	if err != nil { t.Fatal(err) }
+	v := reflect.ValueOf(*back)
+	for i := 0; i < v.NumField(); i++ {
+		assert.False(t, v.Field(i).IsZero(), "unmapped field %s", v.Type().Field(i).Name)
+	}
+}
+
+func TestMapper_RoundTrip(t *testing.T) {
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < mapperTestRuns; i++ {
+
+		inviteCode := mapperTestInviteCode(r)
+
+		// struct -> proto -> struct
+		back, err := inviteCodeFromProto(inviteCodeToProto(inviteCode))
+		if err != nil { t.Fatal(err) }
+		if !assert.Equal(t, inviteCode, back) { return }
+
+		// proto -> struct -> proto
+		res := inviteCodeToProto(inviteCode)
+		mapped, _ := inviteCodeFromProto(res)
+		if !assert.True(t, proto.Equal(res, inviteCodeToProto(mapped))) { return }
+
+		// struct -> bson -> struct (mongodb stores milliseconds)
+		raw, err := bson.Marshal(inviteCode)
+		if err != nil { t.Fatal(err) }
+		decoded := &UserInviteCode{}
+		if err := bson.Unmarshal(raw, decoded); err != nil { t.Fatal(err) }
+		if !assert.Equal(t, mapperTestTruncate(inviteCode), mapperTestTruncate(decoded)) { return }
+	}
+}
+
+func TestMapper_Unset(t *testing.T) {
+
+	res := inviteCodeToProto(&UserInviteCode{MetaCode: "code"})
+	assert.Empty(t, res.GetId())
+	assert.Nil(t, res.GetCreatedAt())
+	assert.Nil(t, res.GetDeletedAt())
+	assert.Nil(t, res.GetUpdatedAt())
+	assert.Nil(t, inviteCodeToProto(nil))
+
+	// unset timestamps are not written to mongodb
+	raw, err := bson.Marshal(&UserInviteCode{MetaCode: "code"})
+	if err != nil { t.Fatal(err) }
+	_, err = bson.Raw(raw).LookupErr("deleted_at")
+	assert.Error(t, err)
+
+	_, err = inviteCodeFromProto(&rfpb.UserInviteCode{Id: "not-an-object-id"})
+	assert.Error(t, err)
+}
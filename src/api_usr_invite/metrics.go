@@ -0,0 +1,107 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"time"
+)
+
+// service specific (opencensus) measures, exported next to the default gRPC server views
+var (
+	metricFaultInjections     = stats.Int64("rf/usr_invite/fault_injections", "Number of injected faults", stats.UnitDimensionless)
+	metricFaultLatency        = stats.Float64("rf/usr_invite/fault_latency", "Injected latency", stats.UnitMilliseconds)
+	metricMongoDbConnected    = stats.Int64("rf/usr_invite/mongodb_connected", "MongoDB connectivity (1 = connected)", stats.UnitDimensionless)
+	metricMongoDbPingFailures = stats.Int64("rf/usr_invite/mongodb_ping_failures", "Number of failed MongoDB pings", stats.UnitDimensionless)
+
+	tagKeyMethod    = tag.MustNewKey("grpc_server_method")
+	tagKeyFaultRule = tag.MustNewKey("fault_rule")
+	tagKeyFaultKind = tag.MustNewKey("fault_kind")
+	tagKeyPhase     = tag.MustNewKey("phase")
+)
+
+var metricViews = []*view.View{
+	{
+		Name:        "rf/usr_invite/fault_injections",
+		Description: "Count of injected faults by method, rule and kind",
+		Measure:     metricFaultInjections,
+		TagKeys:     []tag.Key{tagKeyMethod, tagKeyFaultRule, tagKeyFaultKind},
+		Aggregation: view.Count(),
+	},
+	{
+		Name:        "rf/usr_invite/fault_latency",
+		Description: "Distribution of injected latency by method and rule",
+		Measure:     metricFaultLatency,
+		TagKeys:     []tag.Key{tagKeyMethod, tagKeyFaultRule},
+		Aggregation: view.Distribution(0, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000),
+	},
+	{
+		Name:        "rf/usr_invite/mongodb_connected",
+		Description: "Current MongoDB connectivity (1 = connected, 0 = not connected)",
+		Measure:     metricMongoDbConnected,
+		Aggregation: view.LastValue(),
+	},
+	{
+		Name:        "rf/usr_invite/mongodb_ping_failures",
+		Description: "Count of failed MongoDB pings by phase (startup, monitor)",
+		Measure:     metricMongoDbPingFailures,
+		TagKeys:     []tag.Key{tagKeyPhase},
+		Aggregation: view.Count(),
+	},
+}
+
+//
+// -- gRPC Metric Stack :: service specific opencensus views
+//
+
+func registerMetricViews() error {
+	return view.Register(metricViews...)
+}
+
+func _recordFaultInjection(ctx context.Context, method string, rule string, kind string, latency time.Duration) {
+
+	mutators := []tag.Mutator{
+		tag.Upsert(tagKeyMethod, method),
+		tag.Upsert(tagKeyFaultRule, rule),
+		tag.Upsert(tagKeyFaultKind, kind),
+	}
+
+	measurements := []stats.Measurement{metricFaultInjections.M(1)}
+	if kind == faultKindLatency {
+		measurements = append(measurements, metricFaultLatency.M(float64(latency)/float64(time.Millisecond)))
+	}
+
+	_ = stats.RecordWithTags(ctx, mutators, measurements...)
+}
+
+func _recordMongoDbConnected(connected bool) {
+
+	value := int64(0)
+	if connected {
+		value = 1
+	}
+
+	stats.Record(context.Background(), metricMongoDbConnected.M(value))
+}
+
+func _recordMongoDbPingFailure(phase string) {
+	_ = stats.RecordWithTags(context.Background(), []tag.Mutator{tag.Upsert(tagKeyPhase, phase)}, metricMongoDbPingFailures.M(1))
+}
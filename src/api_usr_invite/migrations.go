@@ -0,0 +1,513 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"io"
+	"os"
+	"sort"
+	"time"
+)
+
+const (
+	metaMigrationUp   = "up"
+	metaMigrationDown = "down"
+
+	metaMigrationCollectionTbl     = "schema_migrations"
+	metaMigrationLockCollectionTbl = "schema_migrations_lock"
+	metaMigrationLockId            = "schema"
+	metaMigrationLockBackoff       = 250 * time.Millisecond
+	metaMigrationLockMaxBackoff    = 5 * time.Second
+	metaMigrationTimeout           = 10 * time.Minute
+)
+
+var errMigrationLocked = errors.New("migrations are locked by another instance")
+
+// migration is a single schema change, versions are applied in ascending and rolled back in descending order.
+// A migration without down function can't be rolled back.
+type migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, db *mongo.Database) error
+	Down        func(ctx context.Context, db *mongo.Database) error
+}
+
+// migrationRecord is stored in schema_migrations for every applied migration
+type migrationRecord struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"applied_at"`
+	AppliedBy   string    `bson:"applied_by"`
+	DurationMs  int64     `bson:"duration_ms"`
+}
+
+// migrationStore persists the applied migrations and the (distributed) migration lock
+type migrationStore interface {
+	applied(ctx context.Context) (map[int]migrationRecord, error)
+	record(ctx context.Context, record migrationRecord) error
+	remove(ctx context.Context, version int) error
+	lock(ctx context.Context, owner string, ttl time.Duration) (bool, error)
+	unlock(ctx context.Context, owner string) error
+}
+
+// migrator runs migrations against db, the lock is held for lockTTL and renewed before every migration, an
+// instance waits up to lockTimeout for the lock of another one (e.g. replicas starting at the same time).
+type migrator struct {
+	store       migrationStore
+	db          *mongo.Database
+	migrations  []migration
+	owner       string
+	lockTTL     time.Duration
+	lockTimeout time.Duration
+	sleep       func(time.Duration)
+}
+
+// mongoDbMigrationStore keeps records and lock in separate collections of the service database
+type mongoDbMigrationStore struct {
+	records *mongo.Collection
+	locks   *mongo.Collection
+}
+
+// mongoDbMigrations lists all schema migrations of the service, versions must never be changed or re-used once
+// released, new migrations are appended.
+var mongoDbMigrations = []migration{
+	{
+		Version:     1,
+		Description: "create invite code indexes (unique meta_code, validity), drop legacy meta_code text index",
+		Up: func(_ context.Context, db *mongo.Database) error {
+			return mongoDbEnsureIndexes(db.Collection(metaMongoDbCollectionTbl))
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			for _, model := range mongoDbIndexModels() {
+				if _, err := db.Collection(metaMongoDbCollectionTbl).Indexes().DropOne(ctx, *model.Options.Name); err != nil && !_isMongoDbIndexNotFound(err) {
+					return fmt.Errorf("unable to drop index [%s]: %v", *model.Options.Name, err)
+				}
+			}
+			return nil
+		},
+	},
+	{
+		Version:     2,
+		Description: "set initial version of invite codes created before optimistic concurrency",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection(metaMongoDbCollectionTbl).UpdateMany(ctx,
+				bson.M{"version": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"version": metaInviteCodeInitialVersion}})
+			return err
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection(metaMongoDbCollectionTbl).UpdateMany(ctx,
+				bson.M{"version": metaInviteCodeInitialVersion}, bson.M{"$unset": bson.M{"version": ""}})
+			return err
+		},
+	},
+	{
+		Version:     3,
+		Description: "create webhook delivery queue index (status, next attempt)",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection(metaWebhookCollectionTbl).Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}}, Options: options.Index().SetName(metaWebhookQueueIndex)})
+			return err
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			if _, err := db.Collection(metaWebhookCollectionTbl).Indexes().DropOne(ctx, metaWebhookQueueIndex); err != nil && !_isMongoDbIndexNotFound(err) {
+				return fmt.Errorf("unable to drop index [%s]: %v", metaWebhookQueueIndex, err)
+			}
+			return nil
+		},
+	},
+	{
+		Version:     4,
+		Description: "create event outbox collection and relay index (next attempt), transactions can't create collections",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection(metaOutboxCollectionTbl).Indexes().CreateOne(ctx, mongo.IndexModel{
+				Keys: bson.D{{Key: "next_attempt_at", Value: 1}, {Key: "_id", Value: 1}}, Options: options.Index().SetName(metaOutboxQueueIndex)})
+			return err
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			if _, err := db.Collection(metaOutboxCollectionTbl).Indexes().DropOne(ctx, metaOutboxQueueIndex); err != nil && !_isMongoDbIndexNotFound(err) {
+				return fmt.Errorf("unable to drop index [%s]: %v", metaOutboxQueueIndex, err)
+			}
+			return nil
+		},
+	},
+	{
+		Version:     5,
+		Description: "create audit event collection and listing indexes (invite code, actor, time)",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection(metaAuditCollectionTbl).Indexes().CreateMany(ctx, mongoDbAuditIndexModels())
+			return err
+		},
+		Down: func(ctx context.Context, db *mongo.Database) error {
+			for _, model := range mongoDbAuditIndexModels() {
+				if _, err := db.Collection(metaAuditCollectionTbl).Indexes().DropOne(ctx, *model.Options.Name); err != nil && !_isMongoDbIndexNotFound(err) {
+					return fmt.Errorf("unable to drop index [%s]: %v", *model.Options.Name, err)
+				}
+			}
+			return nil
+		},
+	},
+}
+
+//
+// -- gRPC Migration Stack 19/n :: versioned schema migrations (schema_migrations, distributed lock)
+//
+
+// newMongoDbMigrator returns the migrator of the service migrations on the given database
+func newMongoDbMigrator(db *mongo.Database, cfg *Config) *migrator {
+
+	return &migrator{
+		store:       &mongoDbMigrationStore{records: db.Collection(metaMigrationCollectionTbl), locks: db.Collection(metaMigrationLockCollectionTbl)},
+		db:          db,
+		migrations:  mongoDbMigrations,
+		owner:       _getMigrationOwner(),
+		lockTTL:     cfg.MigrationLockTTL,
+		lockTimeout: cfg.MigrationLockTimeout,
+		sleep:       time.Sleep,
+	}
+}
+
+// plan returns the migrations to run in order. Up applies all pending migrations up to target (0 = latest), down
+// rolls back all applied migrations above target (-1 = the last applied one only).
+func (m *migrator) plan(ctx context.Context, direction string, target int) ([]migration, error) {
+
+	if err := _validateMigrations(m.migrations); err != nil {
+		return nil, err
+	}
+
+	applied, err := m.store.applied(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read applied migrations: %v", err)
+	}
+
+	var steps []migration
+	switch direction {
+	case metaMigrationUp:
+		for _, mig := range m.migrations {
+			if _, ok := applied[mig.Version]; !ok && (target <= 0 || mig.Version <= target) {
+				steps = append(steps, mig)
+			}
+		}
+	case metaMigrationDown:
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			mig := m.migrations[i]
+			if _, ok := applied[mig.Version]; !ok || mig.Version <= target {
+				continue
+			}
+			if mig.Down == nil {
+				return nil, fmt.Errorf("migration %d (%s) can not be rolled back", mig.Version, mig.Description)
+			}
+			if steps = append(steps, mig); target < 0 {
+				break
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown migration direction [%s]", direction)
+	}
+
+	return steps, nil
+}
+
+// run applies (or rolls back) the planned migrations while holding the lock, a dry-run returns the plan only.
+// The plan is re-read once the lock is held, another instance may have migrated in the meantime.
+func (m *migrator) run(ctx context.Context, direction string, target int, dryRun bool) ([]migration, error) {
+
+	steps, err := m.plan(ctx, direction, target)
+	if err != nil || dryRun || len(steps) == 0 {
+		return steps, err
+	}
+
+	err = _retryWithBackoff(m.lockTimeout, metaMigrationLockBackoff, metaMigrationLockMaxBackoff, m.sleep, func(attempt int) error {
+		locked, err := m.store.lock(ctx, m.owner, m.lockTTL)
+		if err == nil && !locked {
+			log.Infof("%s: migrations: waiting for lock (attempt #%d)",metaServiceName,attempt)
+			err = errMigrationLocked
+		}
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to acquire migration lock: %v", err)
+	}
+
+	defer func() {
+		if err := m.store.unlock(context.Background(), m.owner); err != nil {
+			log.Warnf("%s: migrations: unable to release lock (expires after %v): %v",metaServiceName,m.lockTTL,err)
+		}
+	}()
+
+	if steps, err = m.plan(ctx, direction, target); err != nil {
+		return nil, err
+	}
+
+	for i, mig := range steps {
+		if i > 0 {
+			if locked, err := m.store.lock(ctx, m.owner, m.lockTTL); err != nil || !locked {
+				return steps[:i], fmt.Errorf("migration lock lost before migration %d: %v", mig.Version, err)
+			}
+		}
+
+		if err := m._runMigration(ctx, direction, mig); err != nil {
+			return steps[:i], err
+		}
+	}
+
+	return steps, nil
+}
+
+// mongoDbMigrate runs the migrations of the current connection, used on startup and by fixture seeding
+func mongoDbMigrate() error {
+
+	steps, err := newMongoDbMigrator(mongoDbCurrent().database, configCurrent()).run(metaMongoDbContext, metaMigrationUp, 0, false)
+	if err != nil {
+		return fmt.Errorf("migrations failed after %d applied migration(s): %v", len(steps), err)
+	}
+
+	log.Infof("%s: migrations: schema up to date (%d applied)",metaServiceName,len(steps))
+
+	return nil
+}
+
+// mongoDbMigrateOnStartup applies the pending migrations, a failed run (e.g. duplicate codes blocking the unique
+// meta_code index) keeps health NOT_SERVING and is retried with exponential backoff until it succeeds or the
+// service shuts down.
+func mongoDbMigrateOnStartup(migrate func() error, initial time.Duration, max time.Duration) {
+
+	err := migrate()
+	runtimeSetMigrationError(err)
+	if err == nil {
+		return
+	}
+
+	shutdown := runtimeShutdownStarted()
+	go func() {
+		backoff := initial
+		for attempt := 2; ; attempt++ {
+			select {
+			case <-shutdown:
+				return
+			case <-time.After(backoff):
+			}
+
+			if err := migrate(); err != nil {
+				log.Warnf("%s: mongodb: migration attempt #%d failed: %v",metaServiceName,attempt,err)
+				if backoff *= 2; backoff > max {
+					backoff = max
+				}
+				continue
+			}
+
+			runtimeSetMigrationError(nil)
+			return
+		}
+	}()
+}
+
+// migrateCommand runs --migrate up|down (--migrate-to, --migrate-dry-run) and writes the listing to w
+func migrateCommand(cfg *Config, w io.Writer) error {
+
+	client, err := _newMongoDbClient(cfg)
+	if err != nil {
+		return fmt.Errorf("unable to connect to mongodb: %v", err)
+	}
+	defer client.Disconnect(context.Background())
+
+	m := newMongoDbMigrator(client.Database(cfg.MongoDbPDB), cfg)
+	applied, err := m.store.applied(metaMongoDbContext)
+	if err != nil {
+		return fmt.Errorf("unable to read applied migrations: %v", err)
+	}
+
+	steps, err := m.run(metaMongoDbContext, cfg.Migrate, cfg.MigrateTo, cfg.MigrateDryRun)
+	migrationListing(w, m.migrations, applied, cfg.Migrate, steps, cfg.MigrateDryRun)
+
+	return err
+}
+
+// migrationListing writes the state of all migrations and the planned (dry-run) or executed steps to w
+func migrationListing(w io.Writer, migrations []migration, applied map[int]migrationRecord, direction string, steps []migration, dryRun bool) {
+
+	for _, mig := range migrations {
+		state := "pending"
+		if record, ok := applied[mig.Version]; ok {
+			state = "applied " + record.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%4d  %-28s %s\n", mig.Version, state, mig.Description)
+	}
+
+	verb := "executed"
+	if dryRun {
+		verb = "planned"
+	}
+
+	if len(steps) == 0 {
+		fmt.Fprintf(w, "%s: nothing to do\n", verb)
+		return
+	}
+
+	for _, mig := range steps {
+		fmt.Fprintf(w, "%s: %s %d (%s)\n", verb, direction, mig.Version, mig.Description)
+	}
+}
+
+func (s *mongoDbMigrationStore) applied(ctx context.Context) (map[int]migrationRecord, error) {
+
+	cursor, err := s.records.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	var records []migrationRecord
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	applied := map[int]migrationRecord{}
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+
+	return applied, nil
+}
+
+func (s *mongoDbMigrationStore) record(ctx context.Context, record migrationRecord) error {
+
+	_, err := s.records.ReplaceOne(ctx, bson.M{"_id": record.Version}, record, options.Replace().SetUpsert(true))
+
+	return err
+}
+
+func (s *mongoDbMigrationStore) remove(ctx context.Context, version int) error {
+
+	_, err := s.records.DeleteOne(ctx, bson.M{"_id": version})
+
+	return err
+}
+
+// lock takes over the lock document if it is expired or owned already (renewal), the upsert fails with a
+// duplicate key error if another instance holds the lock.
+func (s *mongoDbMigrationStore) lock(ctx context.Context, owner string, ttl time.Duration) (bool, error) {
+
+	now := time.Now()
+	filter := bson.M{"_id": metaMigrationLockId, "$or": bson.A{bson.M{"expires_at": bson.M{"$lte": now}}, bson.M{"owner": owner}}}
+	update := bson.M{"$set": bson.M{"owner": owner, "locked_at": now, "expires_at": now.Add(ttl)}}
+
+	_, err := s.locks.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if _isMongoDbDuplicateKey(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (s *mongoDbMigrationStore) unlock(ctx context.Context, owner string) error {
+
+	_, err := s.locks.DeleteOne(ctx, bson.M{"_id": metaMigrationLockId, "owner": owner})
+
+	return err
+}
+
+// _validateMigrations returns all migration configuration errors
+func (c *Config) _validateMigrations() []string {
+
+	var errs []string
+	if c.Migrate != "" && c.Migrate != metaMigrationUp && c.Migrate != metaMigrationDown {
+		errs = append(errs, fmt.Sprintf("--migrate: must be %s or %s, got %s", metaMigrationUp, metaMigrationDown, c.Migrate))
+	}
+
+	if c.MigrationLockTTL <= 0 {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_MIGRATION_LOCK_TTL: must be positive, got %v", c.MigrationLockTTL))
+	}
+
+	if c.MigrationLockTimeout < 0 {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_MIGRATION_LOCK_TIMEOUT: must not be negative, got %v", c.MigrationLockTimeout))
+	}
+
+	return errs
+}
+
+//
+// -- sidekick stack for migration helper methods
+//
+
+func (m *migrator) _runMigration(ctx context.Context, direction string, mig migration) error {
+
+	migrationCtx, cancel := context.WithTimeout(ctx, metaMigrationTimeout)
+	defer cancel()
+
+	log.Infof("%s: migrations: %s %d (%s) ...",metaServiceName,direction,mig.Version,mig.Description)
+
+	startedAt := time.Now()
+	if direction == metaMigrationDown {
+		if err := mig.Down(migrationCtx, m.db); err != nil {
+			return fmt.Errorf("migration %d (%s) down failed: %v", mig.Version, mig.Description, err)
+		}
+		if err := m.store.remove(ctx, mig.Version); err != nil {
+			return fmt.Errorf("unable to remove record of migration %d: %v", mig.Version, err)
+		}
+		return nil
+	}
+
+	if err := mig.Up(migrationCtx, m.db); err != nil {
+		return fmt.Errorf("migration %d (%s) up failed: %v", mig.Version, mig.Description, err)
+	}
+
+	record := migrationRecord{Version: mig.Version, Description: mig.Description, AppliedAt: time.Now().UTC(), AppliedBy: m.owner, DurationMs: time.Since(startedAt).Milliseconds()}
+	if err := m.store.record(ctx, record); err != nil {
+		return fmt.Errorf("unable to record migration %d: %v", mig.Version, err)
+	}
+
+	return nil
+}
+
+func _validateMigrations(migrations []migration) error {
+
+	ordered := sort.SliceIsSorted(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	for i, mig := range migrations {
+		switch {
+		case mig.Version <= 0 || mig.Up == nil:
+			return fmt.Errorf("migration #%d: version must be positive and up must be set", i)
+		case !ordered || (i > 0 && migrations[i-1].Version == mig.Version):
+			return fmt.Errorf("migration %d: versions must be unique and in ascending order", mig.Version)
+		}
+	}
+
+	return nil
+}
+
+func _getMigrationOwner() string {
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = metaServiceName
+	}
+
+	return fmt.Sprintf("%s/%d/%s", hostname, os.Getpid(), primitive.NewObjectID().Hex())
+}
+
+func _isMongoDbIndexNotFound(err error) bool {
+
+	var cmdErr mongo.CommandError
+
+	return errors.As(err, &cmdErr) && cmdErr.Code == metaMongoDbIndexNotFoundCode
+}
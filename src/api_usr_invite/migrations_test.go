@@ -0,0 +1,244 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+	rfpbh "google.golang.org/grpc/health/grpc_health_v1"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// migrationTestStore keeps records and lock in memory, owner is the current lock holder
+type migrationTestStore struct {
+	records map[int]migrationRecord
+	owner   string
+	locks   int
+}
+
+//
+// -- core test helper methods :: *.n
+//
+
+func (s *migrationTestStore) applied(context.Context) (map[int]migrationRecord, error) {
+
+	applied := map[int]migrationRecord{}
+	for version, record := range s.records {
+		applied[version] = record
+	}
+
+	return applied, nil
+}
+
+func (s *migrationTestStore) record(_ context.Context, record migrationRecord) error {
+	s.records[record.Version] = record
+	return nil
+}
+
+func (s *migrationTestStore) remove(_ context.Context, version int) error {
+	delete(s.records, version)
+	return nil
+}
+
+func (s *migrationTestStore) lock(_ context.Context, owner string, _ time.Duration) (bool, error) {
+
+	if s.owner != "" && s.owner != owner {
+		return false, nil
+	}
+
+	s.owner = owner; s.locks++
+
+	return true, nil
+}
+
+func (s *migrationTestStore) unlock(_ context.Context, owner string) error {
+
+	if s.owner == owner {
+		s.owner = ""
+	}
+
+	return nil
+}
+
+// migrationTestMigrator returns a migrator of three migrations appending "<direction><version>" to calls
+func migrationTestMigrator(store *migrationTestStore, calls *[]string) *migrator {
+
+	step := func(name string) func(context.Context, *mongo.Database) error {
+		return func(context.Context, *mongo.Database) error { *calls = append(*calls, name); return nil }
+	}
+
+	return &migrator{
+		store: store,
+		migrations: []migration{
+			{Version: 1, Description: "one", Up: step("up1"), Down: step("down1")},
+			{Version: 2, Description: "two", Up: step("up2"), Down: step("down2")},
+			{Version: 3, Description: "three", Up: step("up3"), Down: step("down3")},
+		},
+		owner:       "test",
+		lockTTL:     time.Minute,
+		lockTimeout: time.Second,
+		sleep:       func(time.Duration) {},
+	}
+}
+
+//
+// -- core test methods :: schema migrations
+//
+
+func TestMigrations_Definitions(t *testing.T) {
+
+	assert.NoError(t, _validateMigrations(mongoDbMigrations))
+	for _, mig := range mongoDbMigrations {
+		assert.NotNil(t, mig.Down, mig.Description)
+	}
+
+	up := func(context.Context, *mongo.Database) error { return nil }
+	assert.Error(t, _validateMigrations([]migration{{Version: 2, Up: up}, {Version: 1, Up: up}}))
+	assert.Error(t, _validateMigrations([]migration{{Version: 1, Up: up}, {Version: 1, Up: up}}))
+	assert.Error(t, _validateMigrations([]migration{{Version: 0, Up: up}}))
+	assert.Error(t, _validateMigrations([]migration{{Version: 1}}))
+}
+
+func TestMigrations_UpDown(t *testing.T) {
+
+	var calls []string
+	store := &migrationTestStore{records: map[int]migrationRecord{}}
+	m := migrationTestMigrator(store, &calls)
+
+	steps, err := m.run(ctx, metaMigrationUp, 2, false)
+	assert.NoError(t, err)
+	assert.Len(t, steps, 2)
+	assert.Equal(t, []string{"up1", "up2"}, calls)
+	assert.Equal(t, "test", store.records[2].AppliedBy)
+	assert.Empty(t, store.owner, "lock released")
+
+	_, err = m.run(ctx, metaMigrationUp, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"up1", "up2", "up3"}, calls)
+
+	// down rolls back the last applied migration by default, or all above the target version
+	_, err = m.run(ctx, metaMigrationDown, -1, false)
+	assert.NoError(t, err)
+	_, err = m.run(ctx, metaMigrationDown, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"up1", "up2", "up3", "down3", "down2", "down1"}, calls)
+	assert.Empty(t, store.records)
+
+	// nothing to do, the lock isn't even taken
+	locks := store.locks
+	steps, err = m.run(ctx, metaMigrationDown, 0, false)
+	assert.NoError(t, err)
+	assert.Empty(t, steps)
+	assert.Equal(t, locks, store.locks)
+}
+
+func TestMigrations_DryRun(t *testing.T) {
+
+	var calls []string
+	store := &migrationTestStore{records: map[int]migrationRecord{1: {Version: 1, AppliedAt: time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)}}}
+	m := migrationTestMigrator(store, &calls)
+
+	steps, err := m.run(ctx, metaMigrationUp, 0, true)
+	assert.NoError(t, err)
+	assert.Empty(t, calls)
+	assert.Zero(t, store.locks)
+
+	var out bytes.Buffer
+	applied, _ := store.applied(ctx)
+	migrationListing(&out, m.migrations, applied, metaMigrationUp, steps, true)
+	assert.Contains(t, out.String(), "applied 2020-10-01T00:00:00Z")
+	assert.Contains(t, out.String(), "planned: up 2 (two)\nplanned: up 3 (three)\n")
+}
+
+func TestMigrations_Failure(t *testing.T) {
+
+	var calls []string
+	store := &migrationTestStore{records: map[int]migrationRecord{}}
+	m := migrationTestMigrator(store, &calls)
+	m.migrations[1].Up = func(context.Context, *mongo.Database) error { return errors.New("boom") }
+
+	steps, err := m.run(ctx, metaMigrationUp, 0, false)
+	assert.Error(t, err)
+	assert.Len(t, steps, 1)
+	assert.Contains(t, store.records, 1)
+	assert.NotContains(t, store.records, 2)
+	assert.NotContains(t, store.records, 3)
+	assert.Empty(t, store.owner, "lock released")
+
+	// migrations without down function can't be rolled back
+	m.migrations[0].Down = nil
+	_, err = m.run(ctx, metaMigrationDown, 0, true)
+	assert.Error(t, err)
+}
+
+func TestMigrations_StartupRetry(t *testing.T) {
+
+	connected, _ := runtimeMongoDbState()
+	defer runtimeSetMongoDbConnected(connected)
+	defer runtimeSetMigrationError(runtimeMigrationError())
+	runtimeSetMongoDbConnected(true)
+
+	var attempts int32
+	mongoDbMigrateOnStartup(func() error {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			return errors.New("duplicate key")
+		}
+		return nil
+	}, time.Millisecond, 2*time.Millisecond)
+
+	// the missing unique index keeps the service out of rotation until a retry succeeds
+	assert.Error(t, runtimeMigrationError())
+	assert.Eventually(t, func() bool { return runtimeHealthStatus() == rfpbh.HealthCheckResponse_SERVING }, time.Second, time.Millisecond)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	assert.NoError(t, runtimeMigrationError())
+}
+
+func TestMigrations_Lock(t *testing.T) {
+
+	var calls []string
+	store := &migrationTestStore{records: map[int]migrationRecord{}, owner: "replica-2"}
+	m := migrationTestMigrator(store, &calls)
+
+	_, err := m.run(ctx, metaMigrationUp, 0, false)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), errMigrationLocked.Error())
+	assert.Empty(t, calls)
+
+	// the other instance migrated meanwhile, the plan is re-read once the lock is held
+	m.sleep = func(time.Duration) {
+		for _, version := range []int{1, 2, 3} {
+			store.records[version] = migrationRecord{Version: version}
+		}
+		store.owner = ""
+	}
+	steps, err := m.run(ctx, metaMigrationUp, 0, false)
+	assert.NoError(t, err)
+	assert.Empty(t, steps)
+	assert.Empty(t, calls)
+}
+
+func TestMigrations_Config(t *testing.T) {
+
+	cfg := &Config{Migrate: "sideways", MigrationLockTTL: 0, MigrationLockTimeout: -time.Second}
+	assert.Len(t, cfg._validateMigrations(), 3)
+}
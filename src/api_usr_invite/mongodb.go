@@ -0,0 +1,472 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+	"go.mongodb.org/mongo-driver/x/bsonx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	metaMongoDbAuthX509     = "MONGODB-X509"
+	metaMongoDbPhaseStartup = "startup"
+	metaMongoDbPhaseMonitor = "monitor"
+
+	metaMongoDbIndexTimeout      = 2 * time.Minute
+	metaMongoDbCodeIndex         = "meta_code_uq"
+	metaMongoDbLegacyCodeIndex   = "meta_code_idx"
+	metaMongoDbIndexNotFoundCode = 27
+)
+
+var (
+	// mongoDbAuthMechanisms lists the mechanisms supported by DB_MONGO_AUTH_MECHANISM, empty = negotiated
+	mongoDbAuthMechanisms = []string{"", "SCRAM-SHA-1", "SCRAM-SHA-256", "PLAIN", "GSSAPI", metaMongoDbAuthX509}
+	mongoDbReadConcerns   = []string{"", "local", "available", "majority", "linearizable", "snapshot"}
+
+	mongoDbMonitorOnce sync.Once
+
+	// metaMongoDb holds the *mongoDbConnection in use, read it via mongoDbCurrent only
+	metaMongoDb   atomic.Value
+	metaMongoDbMu sync.Mutex
+)
+
+// mongoDbConnection is the client of the service database and its collections, a reconnect (rotated credentials)
+// replaces the connection as a whole, so readers never see a client and collections of different connections.
+type mongoDbConnection struct {
+	client         *mongo.Client
+	database       *mongo.Database
+	collection     *mongo.Collection
+	listCollection *mongo.Collection
+}
+
+//
+// -- gRPC MongoDb Stack 11/n :: connection && pool options
+//
+
+func newMongoDbConnection(client *mongo.Client, cfg *Config) *mongoDbConnection {
+
+	database := client.Database(cfg.MongoDbPDB)
+
+	return &mongoDbConnection{
+		client:         client,
+		database:       database,
+		collection:     database.Collection(metaMongoDbCollectionTbl),
+		listCollection: mongoDbListCollection(database, cfg),
+	}
+}
+
+// mongoDbCurrent returns the connection in use, nil before the service connected to mongodb
+func mongoDbCurrent() *mongoDbConnection {
+
+	conn, _ := metaMongoDb.Load().(*mongoDbConnection)
+
+	return conn
+}
+
+// mongoDbSwapConnection makes conn the connection in use and returns the previous one (nil if none)
+func mongoDbSwapConnection(conn *mongoDbConnection) *mongoDbConnection {
+
+	metaMongoDbMu.Lock()
+	defer metaMongoDbMu.Unlock()
+
+	previous := mongoDbCurrent()
+	metaMongoDb.Store(conn)
+
+	return previous
+}
+
+// mongoDbClientOptions builds the client options of the given configuration, explicitly configured
+// settings override the corresponding parameters of DB_MONGO_LNK, defaults only apply to parameters the
+// connection string leaves unset. Credentials are only applied if DB_MONGO_USR is set (or x509 auth is
+// used), so auth-less local instances work as well.
+func mongoDbClientOptions(cfg *Config) *options.ClientOptions {
+
+	clientOptions := options.Client().ApplyURI(cfg.MongoDbLnk)
+	override := func(inURI bool, key string) bool { return !inURI || cfg.isExplicit(key) }
+
+	if override(clientOptions.AppName != nil, "DB_MONGO_APP_NAME") {
+		clientOptions.SetAppName(cfg.MongoDbAppName)
+	}
+	if override(clientOptions.MaxPoolSize != nil, "DB_MONGO_MAX_POOL_SIZE") {
+		clientOptions.SetMaxPoolSize(uint64(cfg.MongoDbMaxPoolSize))
+	}
+	if override(clientOptions.MinPoolSize != nil, "DB_MONGO_MIN_POOL_SIZE") {
+		clientOptions.SetMinPoolSize(uint64(cfg.MongoDbMinPoolSize))
+	}
+	if override(clientOptions.ConnectTimeout != nil, "DB_MONGO_CONNECT_TIMEOUT") {
+		clientOptions.SetConnectTimeout(cfg.MongoDbConnectTimeout)
+	}
+	if override(clientOptions.ServerSelectionTimeout != nil, "DB_MONGO_SERVER_SELECTION_TIMEOUT") {
+		clientOptions.SetServerSelectionTimeout(cfg.MongoDbServerSelectionTimeout)
+	}
+	if override(clientOptions.RetryWrites != nil, "DB_MONGO_RETRY_WRITES") {
+		clientOptions.SetRetryWrites(cfg.MongoDbRetryWrites)
+	}
+
+	if cfg.MongoDbUsr != "" || cfg.MongoDbAuthMechanism == metaMongoDbAuthX509 {
+		authSource := cfg.MongoDbAuthSource
+		if authSource == "" && cfg.MongoDbAuthMechanism != metaMongoDbAuthX509 {
+			authSource = cfg.MongoDbPDB
+		}
+		clientOptions.SetAuth(options.Credential{
+			AuthMechanism: cfg.MongoDbAuthMechanism,
+			AuthSource:    authSource,
+			Username:      cfg.MongoDbUsr,
+			Password:      cfg.MongoDbPwd,
+			PasswordSet:   cfg.MongoDbPwd != "",
+		})
+	}
+
+	if cfg.MongoDbReadConcern != "" {
+		clientOptions.SetReadConcern(readconcern.New(readconcern.Level(cfg.MongoDbReadConcern)))
+	}
+
+	if wc, err := _getMongoDbWriteConcern(cfg.MongoDbWriteConcern); err == nil && wc != nil {
+		clientOptions.SetWriteConcern(wc)
+	}
+
+	return clientOptions
+}
+
+// mongoDbListCollection returns the collection used by the List RPCs, reading with DB_MONGO_LIST_READ_PREFERENCE
+// (e.g. secondaryPreferred to take the load of (streamed) list calls off the primary).
+func mongoDbListCollection(db *mongo.Database, cfg *Config) *mongo.Collection {
+
+	collectionOptions := options.Collection()
+	if rp, err := _getMongoDbReadPreference(cfg.MongoDbListReadPreference); err == nil {
+		collectionOptions.SetReadPreference(rp)
+	}
+
+	return db.Collection(metaMongoDbCollectionTbl, collectionOptions)
+}
+
+// mongoDbIndexModels are the indexes of the invite code collection, meta_code is unique among all codes which are
+// not (soft) deleted, so a deleted code can be created again.
+func mongoDbIndexModels() []mongo.IndexModel {
+
+	return []mongo.IndexModel{
+		{
+			Keys: bsonx.Doc{{Key: "meta_valid_from", Value: bsonx.Int32(-1)}},
+			Options: options.Index().SetName("meta_valid_from_idx").SetSparse(true),
+		},
+		{
+			Keys: bsonx.Doc{{Key: "meta_valid_to", Value: bsonx.Int32(-1)}},
+			Options: options.Index().SetName("meta_valid_to_idx").SetSparse(true),
+		},
+		{
+			Keys: bsonx.Doc{{Key: "meta_code", Value: bsonx.Int32(1)}},
+			Options: options.Index().SetName(metaMongoDbCodeIndex).SetUnique(true).SetPartialFilterExpression(bson.M{"is_deleted": false}),
+		},
+	}
+}
+
+// mongoDbEnsureIndexes creates all (missing) indexes and drops the former unique text index on meta_code, which
+// did not guarantee unique codes. Creating existing indexes is a no-op (migration 1).
+func mongoDbEnsureIndexes(collection *mongo.Collection) error {
+
+	ctx, cancel := context.WithTimeout(metaMongoDbContext, metaMongoDbIndexTimeout)
+	defer cancel()
+
+	_, err := collection.Indexes().DropOne(ctx, metaMongoDbLegacyCodeIndex)
+	if err != nil && !_isMongoDbIndexNotFound(err) {
+		return fmt.Errorf("unable to drop legacy index [%s]: %v", metaMongoDbLegacyCodeIndex, err)
+	}
+
+	if _, err := collection.Indexes().CreateMany(ctx, mongoDbIndexModels()); err != nil {
+		return fmt.Errorf("unable to create indexes (duplicate codes have to be removed first): %v", err)
+	}
+
+	return nil
+}
+
+//
+// -- gRPC MongoDb Stack 12/n :: startup retry && connectivity monitor
+//
+
+// mongoDbWaitForConnection pings mongodb with exponential backoff until it answers, the total backoff is
+// bounded by DB_MONGO_CONNECT_RETRY_TIMEOUT (e.g. mongodb container still starting in docker-compose).
+func mongoDbWaitForConnection(client *mongo.Client, cfg *Config) error {
+
+	return _retryWithBackoff(cfg.MongoDbConnectRetryTimeout, cfg.MongoDbConnectBackoff, cfg.MongoDbConnectMaxBackoff, time.Sleep, func(attempt int) error {
+		err := _pingMongoDb(client, cfg.MongoDbConnectTimeout)
+		if err != nil {
+			_recordMongoDbPingFailure(metaMongoDbPhaseStartup)
+			log.Warnf("%s: mongodb: connection attempt #%d failed: %v",metaServiceName,attempt,err)
+		}
+		return err
+	})
+}
+
+// mongoDbStartMonitor checks the connectivity of the current client periodically (once per process, until
+// shutdown), the driver reconnects on its own, the monitor only flips health and metrics accordingly.
+func mongoDbStartMonitor(interval time.Duration) {
+
+	if interval <= 0 {
+		return
+	}
+
+	mongoDbMonitorOnce.Do(func() {
+		shutdown := runtimeShutdownStarted()
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-shutdown:
+					return
+				case <-ticker.C:
+				}
+
+				conn := mongoDbCurrent()
+				if conn == nil {
+					continue
+				}
+				err := _pingMongoDb(conn.client, configCurrent().MongoDbConnectTimeout)
+				if err != nil {
+					_recordMongoDbPingFailure(metaMongoDbPhaseMonitor)
+					log.Debugf("%s: mongodb: ping failed: %v",metaServiceName,err)
+				}
+				runtimeSetMongoDbConnected(err == nil)
+			}
+		}()
+	})
+}
+
+//
+// -- gRPC MongoDb Stack 13/n :: per-request operation contexts
+//
+
+// mongoDbOperationContext derives the context of a database operation from the RPC context, so client
+// cancellations and gRPC deadlines stop the query. The operation is capped by timeout (0 = no cap) and
+// cancelled on shutdown once draining is over (tracked by the runtime until the returned cancel is called).
+// Calls hit by a mongo_timeout fault wait for the injected timeout and get an expired context.
+func mongoDbOperationContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+
+	var opCtx context.Context
+	var cancel context.CancelFunc
+	if injected := faultMongoTimeout(ctx); injected > 0 {
+		_ = _sleepWithContext(ctx, injected)
+		opCtx, cancel = context.WithDeadline(ctx, time.Now())
+	} else if timeout > 0 {
+		opCtx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		opCtx, cancel = context.WithCancel(ctx)
+	}
+
+	untrack := runtimeTrackOperation(cancel)
+
+	return opCtx, func() {
+		untrack()
+		cancel()
+	}
+}
+
+// mongoDbStatusError maps the error of a database operation to a gRPC status, cancelled and timed out
+// operations report Canceled/DeadlineExceeded (Unavailable on shutdown) instead of the given code.
+func mongoDbStatusError(opCtx context.Context, err error, code codes.Code, msg string) error {
+
+	if ctxErr := _getMongoDbContextError(opCtx, err); ctxErr != nil {
+		return ctxErr
+	}
+
+	return status.Errorf(code, msg)
+}
+
+// _validateMongoDb returns all mongodb related configuration errors
+func (c *Config) _validateMongoDb() []string {
+
+	var errs []string
+	if c.MongoDbPDB == "" {
+		errs = append(errs, "DB_MONGO_PDB: required")
+	}
+
+	if c.MongoDbLnk == "" {
+		errs = append(errs, "DB_MONGO_LNK: required")
+	} else if !strings.HasPrefix(c.MongoDbLnk, "mongodb://") && !strings.HasPrefix(c.MongoDbLnk, "mongodb+srv://") {
+		errs = append(errs, "DB_MONGO_LNK: scheme must be mongodb:// or mongodb+srv://")
+	} else if err := options.Client().ApplyURI(c.MongoDbLnk).Validate(); err != nil {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_LNK: invalid connection string: %v", err))
+	}
+
+	if c.MongoDbUsr != "" && c.MongoDbPwd == "" && c.MongoDbAuthMechanism != metaMongoDbAuthX509 && c.MongoDbAuthMechanism != "GSSAPI" {
+		errs = append(errs, "DB_MONGO_PWD: required if DB_MONGO_USR is set")
+	}
+
+	if !_isMongoDbOption(mongoDbAuthMechanisms, c.MongoDbAuthMechanism) {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_AUTH_MECHANISM: must be one of [%s], got %s", strings.Join(mongoDbAuthMechanisms[1:], ", "), c.MongoDbAuthMechanism))
+	}
+
+	if c.MongoDbMaxPoolSize < 0 {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_MAX_POOL_SIZE: must not be negative, got %d", c.MongoDbMaxPoolSize))
+	}
+
+	if c.MongoDbMinPoolSize < 0 || (c.MongoDbMaxPoolSize > 0 && c.MongoDbMinPoolSize > c.MongoDbMaxPoolSize) {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_MIN_POOL_SIZE: must be within [0,DB_MONGO_MAX_POOL_SIZE], got %d", c.MongoDbMinPoolSize))
+	}
+
+	if c.MongoDbConnectTimeout <= 0 {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_CONNECT_TIMEOUT: must be positive, got %v", c.MongoDbConnectTimeout))
+	}
+
+	if c.MongoDbServerSelectionTimeout <= 0 {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_SERVER_SELECTION_TIMEOUT: must be positive, got %v", c.MongoDbServerSelectionTimeout))
+	}
+
+	if c.MongoDbConnectRetryTimeout < 0 {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_CONNECT_RETRY_TIMEOUT: must not be negative, got %v", c.MongoDbConnectRetryTimeout))
+	}
+
+	if c.MongoDbConnectBackoff <= 0 || c.MongoDbConnectMaxBackoff < c.MongoDbConnectBackoff {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_CONNECT_BACKOFF: must be within (0,DB_MONGO_CONNECT_MAX_BACKOFF], got %v", c.MongoDbConnectBackoff))
+	}
+
+	if c.MongoDbMonitorInterval < 0 {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_MONITOR_INTERVAL: must not be negative, got %v", c.MongoDbMonitorInterval))
+	}
+
+	if c.MongoDbOperationTimeout < 0 || c.MongoDbListTimeout < 0 {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_OPERATION_TIMEOUT/DB_MONGO_LIST_TIMEOUT: must not be negative, got %v/%v", c.MongoDbOperationTimeout, c.MongoDbListTimeout))
+	}
+
+	if c.MongoDbListBatchSize < 1 || c.MongoDbListBatchSize > math.MaxInt32 {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_LIST_BATCH_SIZE: must be within [1,%d], got %d", math.MaxInt32, c.MongoDbListBatchSize))
+	}
+
+	if c.MongoDbListMaxResults < 0 {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_LIST_MAX_RESULTS: must not be negative, got %d", c.MongoDbListMaxResults))
+	}
+
+	if !_isMongoDbOption(mongoDbReadConcerns, c.MongoDbReadConcern) {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_READ_CONCERN: must be one of [%s], got %s", strings.Join(mongoDbReadConcerns[1:], ", "), c.MongoDbReadConcern))
+	}
+
+	if _, err := _getMongoDbWriteConcern(c.MongoDbWriteConcern); err != nil {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_WRITE_CONCERN: %v", err))
+	}
+
+	if _, err := _getMongoDbReadPreference(c.MongoDbListReadPreference); err != nil {
+		errs = append(errs, fmt.Sprintf("DB_MONGO_LIST_READ_PREFERENCE: %v", err))
+	}
+
+	return errs
+}
+
+//
+// -- sidekick stack for mongodb option helper methods
+//
+
+// _getMongoDbWriteConcern parses "majority" or the number of acknowledging nodes, empty = server default
+func _getMongoDbWriteConcern(value string) (*writeconcern.WriteConcern, error) {
+
+	switch value {
+	case "":
+		return nil, nil
+	case "majority":
+		return writeconcern.New(writeconcern.WMajority()), nil
+	}
+
+	w, err := strconv.Atoi(value)
+	if err != nil || w < 0 {
+		return nil, fmt.Errorf("must be majority or a non-negative number, got %s", value)
+	}
+
+	return writeconcern.New(writeconcern.W(w)), nil
+}
+
+func _getMongoDbReadPreference(value string) (*readpref.ReadPref, error) {
+
+	mode, err := readpref.ModeFromString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return readpref.New(mode)
+}
+
+// _retryWithBackoff calls fn until it succeeds, the backoff starts at initial and is doubled per attempt
+// (capped at max), it gives up once the next backoff would exceed the total backoff budget.
+func _retryWithBackoff(budget time.Duration, initial time.Duration, max time.Duration, sleep func(time.Duration), fn func(attempt int) error) error {
+
+	backoff, waited := initial, time.Duration(0)
+	for attempt := 1; ; attempt++ {
+		err := fn(attempt)
+		if err == nil {
+			return nil
+		}
+
+		if waited+backoff > budget {
+			return fmt.Errorf("giving up after %d attempt(s) and %v backoff: %v", attempt, waited, err)
+		}
+
+		sleep(backoff)
+		waited += backoff
+		if backoff *= 2; backoff > max {
+			backoff = max
+		}
+	}
+}
+
+func _pingMongoDb(client *mongo.Client, timeout time.Duration) error {
+
+	pingCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return client.Ping(pingCtx, nil)
+}
+
+func _getMongoDbContextError(opCtx context.Context, err error) error {
+
+	switch {
+	case runtimeIsShuttingDown():
+		return status.Errorf(codes.Unavailable, "%s is shutting down, please retry on another instance", metaServiceName)
+	case opCtx.Err() == context.Canceled || errors.Is(err, context.Canceled):
+		return status.Errorf(codes.Canceled, "Operation canceled")
+	case opCtx.Err() == context.DeadlineExceeded || errors.Is(err, context.DeadlineExceeded):
+		return status.Errorf(codes.DeadlineExceeded, "Operation timed out")
+	}
+
+	return nil
+}
+
+func _isMongoDbOption(valid []string, value string) bool {
+
+	for _, option := range valid {
+		if option == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,327 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"google.golang.org/grpc/codes"
+	rfpbh "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+//
+// -- core test methods :: mongodb connection options
+//
+
+func TestMongoDbOptions_FromConfig(t *testing.T) {
+
+	envFile := configTestEnvFilePath(t, configTestEnvFile+`
+DB_MONGO_AUTH_MECHANISM=SCRAM-SHA-256
+DB_MONGO_MAX_POOL_SIZE=20
+DB_MONGO_MIN_POOL_SIZE=5
+DB_MONGO_SERVER_SELECTION_TIMEOUT=3s
+DB_MONGO_READ_CONCERN=majority
+DB_MONGO_WRITE_CONCERN=majority
+DB_MONGO_RETRY_WRITES=false
+DB_MONGO_LIST_READ_PREFERENCE=secondaryPreferred
+`)
+	defer os.Remove(envFile)
+	defer configTestSetEnv(map[string]string{"DB_MONGO_USR": "", "DB_MONGO_PWD": "", "DB_MONGO_PDB": "", "DB_MONGO_LNK": "", "PORT": "", "ADMIN_PORT": ""})()
+
+	cfg, err := loadConfig([]string{"--env-file", envFile})
+	if err != nil { t.Fatal(err) }
+
+	opts := mongoDbClientOptions(cfg)
+	assert.Equal(t, metaServiceName, *opts.AppName)
+	assert.Equal(t, uint64(20), *opts.MaxPoolSize)
+	assert.Equal(t, uint64(5), *opts.MinPoolSize)
+	assert.Equal(t, 10*time.Second, *opts.ConnectTimeout)
+	assert.Equal(t, 3*time.Second, *opts.ServerSelectionTimeout)
+	assert.Equal(t, "majority", opts.ReadConcern.GetLevel())
+	assert.Equal(t, "majority", opts.WriteConcern.GetW())
+	assert.False(t, *opts.RetryWrites)
+	assert.Equal(t, "SCRAM-SHA-256", opts.Auth.AuthMechanism)
+	assert.Equal(t, "file-db", opts.Auth.AuthSource)
+	assert.Equal(t, "file-usr", opts.Auth.Username)
+
+	rp, err := _getMongoDbReadPreference(cfg.MongoDbListReadPreference)
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, readpref.SecondaryPreferredMode, rp.Mode())
+}
+
+func TestMongoDbOptions_WithoutAuth(t *testing.T) {
+
+	cfg := &Config{MongoDbPDB: "db", MongoDbLnk: "mongodb://localhost:27017/?replicaSet=rs0&w=2", MongoDbMaxPoolSize: 100,
+		MongoDbConnectTimeout: time.Second, MongoDbServerSelectionTimeout: time.Second, MongoDbListReadPreference: "primary",
+		MongoDbConnectBackoff: time.Second, MongoDbConnectMaxBackoff: time.Second, MongoDbListBatchSize: 100}
+	assert.Empty(t, cfg._validateMongoDb())
+
+	// auth-less local instances, replica set and write concern of the connection string are kept
+	opts := mongoDbClientOptions(cfg)
+	assert.Nil(t, opts.Auth)
+	assert.Equal(t, "rs0", *opts.ReplicaSet)
+	assert.Equal(t, 2, opts.WriteConcern.GetW())
+
+	// x509 auth requires neither password nor auth source (defaults to $external)
+	cfg.MongoDbAuthMechanism = metaMongoDbAuthX509
+	opts = mongoDbClientOptions(cfg)
+	assert.Equal(t, metaMongoDbAuthX509, opts.Auth.AuthMechanism)
+	assert.Empty(t, opts.Auth.AuthSource)
+}
+
+func TestMongoDbOptions_ConnectionString(t *testing.T) {
+
+	cfg := &Config{MongoDbLnk: "mongodb://localhost:27017/?appName=uri-app&maxPoolSize=7&retryWrites=false&connectTimeoutMS=2000",
+		MongoDbAppName: "grpc_usr_invite", MongoDbMaxPoolSize: 100, MongoDbConnectTimeout: 10 * time.Second, MongoDbRetryWrites: true,
+		MongoDbServerSelectionTimeout: 30 * time.Second, sources: map[string]string{"DB_MONGO_MAX_POOL_SIZE": configSourceDefault}}
+
+	// defaults don't override the parameters of the connection string
+	opts := mongoDbClientOptions(cfg)
+	assert.Equal(t, "uri-app", *opts.AppName)
+	assert.Equal(t, uint64(7), *opts.MaxPoolSize)
+	assert.False(t, *opts.RetryWrites)
+	assert.Equal(t, 2*time.Second, *opts.ConnectTimeout)
+	assert.Equal(t, 30*time.Second, *opts.ServerSelectionTimeout)
+
+	// ... explicitly configured settings do
+	cfg.sources["DB_MONGO_MAX_POOL_SIZE"], cfg.sources["DB_MONGO_RETRY_WRITES"] = configSourceEnv, configSourceFlag
+	opts = mongoDbClientOptions(cfg)
+	assert.Equal(t, uint64(100), *opts.MaxPoolSize)
+	assert.True(t, *opts.RetryWrites)
+	assert.Equal(t, "uri-app", *opts.AppName)
+}
+
+func TestMongoDbOptions_Validation(t *testing.T) {
+
+	cfg := &Config{MongoDbUsr: "usr", MongoDbPDB: "db", MongoDbLnk: "mongodb://localhost:27017/",
+		MongoDbAuthMechanism: "MD5", MongoDbMaxPoolSize: 5, MongoDbMinPoolSize: 10, MongoDbReadConcern: "strong",
+		MongoDbWriteConcern: "all", MongoDbListReadPreference: "tertiary"}
+
+	errs := strings.Join(cfg._validateMongoDb(), "; ")
+	for _, msg := range []string{
+		"DB_MONGO_PWD: required if DB_MONGO_USR is set",
+		"DB_MONGO_AUTH_MECHANISM: must be one of",
+		"DB_MONGO_MIN_POOL_SIZE: must be within [0,DB_MONGO_MAX_POOL_SIZE], got 10",
+		"DB_MONGO_CONNECT_TIMEOUT: must be positive",
+		"DB_MONGO_SERVER_SELECTION_TIMEOUT: must be positive",
+		"DB_MONGO_CONNECT_BACKOFF: must be within (0,DB_MONGO_CONNECT_MAX_BACKOFF]",
+		"DB_MONGO_READ_CONCERN: must be one of",
+		"DB_MONGO_WRITE_CONCERN: must be majority or a non-negative number, got all",
+		"DB_MONGO_LIST_READ_PREFERENCE: unknown read preference tertiary",
+	} {
+		assert.Contains(t, errs, msg)
+	}
+}
+
+func TestMongoDbRetry_Backoff(t *testing.T) {
+
+	var sleeps []time.Duration
+	sleep := func(d time.Duration) { sleeps = append(sleeps, d) }
+
+	err := _retryWithBackoff(time.Minute, 100*time.Millisecond, 400*time.Millisecond, sleep, func(attempt int) error {
+		if attempt < 5 {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 400 * time.Millisecond}, sleeps)
+
+	// the total backoff is bounded, the last error is reported
+	sleeps = nil
+	err = _retryWithBackoff(time.Second, 300*time.Millisecond, time.Second, sleep, func(int) error { return errors.New("connection refused") })
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "giving up after 3 attempt(s) and 900ms backoff: connection refused")
+	assert.Equal(t, []time.Duration{300 * time.Millisecond, 600 * time.Millisecond}, sleeps)
+}
+
+func TestMongoDbRetry_Unreachable(t *testing.T) {
+
+	cfg := &Config{MongoDbPDB: "db", MongoDbLnk: "mongodb://localhost:1/", MongoDbConnectTimeout: 200 * time.Millisecond,
+		MongoDbServerSelectionTimeout: 100 * time.Millisecond, MongoDbConnectBackoff: time.Millisecond, MongoDbConnectMaxBackoff: time.Millisecond}
+
+	// connecting never fails up-front, the ping does
+	client, err := mongo.Connect(ctx, mongoDbClientOptions(cfg))
+	if err != nil { t.Fatal(err) }
+	defer client.Disconnect(ctx)
+
+	err = mongoDbWaitForConnection(client, cfg)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "giving up after 1 attempt(s)")
+}
+
+func TestMongoDbConnectivity_Health(t *testing.T) {
+
+	connected, _ := runtimeMongoDbState()
+	defer runtimeSetMongoDbConnected(connected)
+
+	runtimeSetMongoDbConnected(true)
+	assert.Equal(t, rfpbh.HealthCheckResponse_SERVING, runtimeHealthStatus())
+
+	_, changedAt := runtimeMongoDbState()
+	runtimeSetMongoDbConnected(true)
+	_, unchangedAt := runtimeMongoDbState()
+	assert.Equal(t, changedAt, unchangedAt)
+
+	runtimeSetMongoDbConnected(false)
+	assert.Equal(t, rfpbh.HealthCheckResponse_NOT_SERVING, runtimeHealthStatus())
+
+	res, err := AdminServiceServer{}.GetRuntimeState(ctx, nil)
+	if err != nil { t.Fatal(err) }
+	assert.False(t, res.GetMongodbConnected())
+	assert.NotNil(t, res.GetMongodbChangedAt())
+}
+
+func TestMongoDbConnection_Swap(t *testing.T) {
+
+	cfg := &Config{MongoDbPDB: "db", MongoDbLnk: "mongodb://localhost:1/", MongoDbListReadPreference: "secondaryPreferred"}
+	defer metaMongoDb.Store(mongoDbCurrent())
+
+	clients := make([]*mongo.Client, 2)
+	for i := range clients {
+		client, err := mongo.Connect(ctx, mongoDbClientOptions(cfg))
+		if err != nil { t.Fatal(err) }
+		defer client.Disconnect(ctx)
+		clients[i] = client
+	}
+
+	first := newMongoDbConnection(clients[0], cfg)
+	assert.Equal(t, "db", first.database.Name())
+	assert.Equal(t, metaMongoDbCollectionTbl, first.listCollection.Name())
+	mongoDbSwapConnection(first)
+
+	// readers see the client and collections of one connection (go test -race)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			conn := mongoDbCurrent()
+			assert.Equal(t, conn.client, conn.collection.Database().Client())
+		}
+	}()
+
+	assert.Equal(t, first, mongoDbSwapConnection(newMongoDbConnection(clients[1], cfg)))
+	<-done
+	assert.Equal(t, clients[1], mongoDbCurrent().client)
+}
+
+func TestMongoDbOperation_Context(t *testing.T) {
+
+	// the operation timeout caps the RPC deadline ...
+	opCtx, cancel := mongoDbOperationContext(ctx, time.Second)
+	deadline, ok := opCtx.Deadline()
+	assert.True(t, ok)
+	assert.True(t, time.Until(deadline) <= time.Second)
+	cancel()
+
+	// ... but never extends it
+	rpcCtx, rpcCancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	opCtx, cancel = mongoDbOperationContext(rpcCtx, time.Minute)
+	defer cancel()
+	rpcDeadline, _ := rpcCtx.Deadline()
+	deadline, _ = opCtx.Deadline()
+	assert.Equal(t, rpcDeadline, deadline)
+
+	// client cancellations are passed on
+	rpcCancel()
+	<-opCtx.Done()
+	assert.Equal(t, context.Canceled, opCtx.Err())
+}
+
+func TestMongoDbOperation_Shutdown(t *testing.T) {
+
+	runtimeTestReset()
+	defer runtimeTestReset()
+
+	opCtx, cancel := mongoDbOperationContext(ctx, 0)
+	defer cancel()
+
+	// finished operations are no longer tracked
+	_, doneCancel := mongoDbOperationContext(ctx, 0)
+	doneCancel()
+	metaRuntime.lifecycleMu.RLock()
+	assert.Len(t, metaRuntime.operations, 1)
+	metaRuntime.lifecycleMu.RUnlock()
+
+	runtimeShutdown(); runtimeShutdown()
+	<-opCtx.Done()
+
+	// operations started after shutdown are cancelled at once
+	lateCtx, lateCancel := mongoDbOperationContext(ctx, 0)
+	defer lateCancel()
+	assert.Equal(t, context.Canceled, lateCtx.Err())
+
+	err := mongoDbStatusError(opCtx, opCtx.Err(), codes.Internal, "query failed")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestMongoDbIndexes_Models(t *testing.T) {
+
+	indexes := map[string]mongo.IndexModel{}
+	for _, model := range mongoDbIndexModels() {
+		indexes[*model.Options.Name] = model
+	}
+
+	// meta_code is a regular (not a text) index, unique among codes which are not deleted
+	codeIndex, ok := indexes[metaMongoDbCodeIndex]
+	if !ok { t.Fatalf("missing index %s", metaMongoDbCodeIndex) }
+	raw, err := bson.Marshal(codeIndex.Keys)
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, int32(1), bson.Raw(raw).Lookup("meta_code").Int32())
+	assert.True(t, *codeIndex.Options.Unique)
+	assert.Equal(t, bson.M{"is_deleted": false}, codeIndex.Options.PartialFilterExpression)
+
+	_, ok = indexes[metaMongoDbLegacyCodeIndex]
+	assert.False(t, ok)
+}
+
+func TestMongoDbOperation_StatusError(t *testing.T) {
+
+	cfg := &Config{MongoDbPDB: "db", MongoDbLnk: "mongodb://localhost:1/", MongoDbServerSelectionTimeout: 5 * time.Second}
+	client, err := mongo.Connect(ctx, mongoDbClientOptions(cfg))
+	if err != nil { t.Fatal(err) }
+	defer client.Disconnect(ctx)
+
+	// the RPC deadline stops the query (instead of the 5s server selection timeout)
+	rpcCtx, rpcCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer rpcCancel()
+	opCtx, cancel := mongoDbOperationContext(rpcCtx, time.Minute)
+	defer cancel()
+
+	started := time.Now()
+	err = client.Database("db").Collection(metaMongoDbCollectionTbl).FindOne(opCtx, bson.M{}).Err()
+	assert.Error(t, err)
+	assert.True(t, time.Since(started) < 5*time.Second)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(mongoDbStatusError(opCtx, err, codes.NotFound, "not found")))
+
+	canceledCtx, cancelNow := context.WithCancel(ctx)
+	cancelNow()
+	assert.Equal(t, codes.Canceled, status.Code(mongoDbStatusError(canceledCtx, canceledCtx.Err(), codes.Internal, "failed")))
+
+	// all other errors keep the given code
+	assert.Equal(t, codes.NotFound, status.Code(mongoDbStatusError(ctx, mongo.ErrNoDocuments, codes.NotFound, "not found")))
+}
@@ -0,0 +1,539 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	metaOutboxCollectionTbl = "event_outbox"
+	metaOutboxQueueIndex    = "next_attempt_at"
+
+	metaInviteCodeEventCreated  = "invite_code.created"
+	metaInviteCodeEventDeleted  = "invite_code.deleted"
+	metaInviteCodeEventRedeemed = "invite_code.redeemed"
+
+	metaEventBrokerInProcess = "inprocess"
+)
+
+var (
+	eventBrokerMu sync.RWMutex
+	eventBrokers  = map[string]eventBrokerFactory{
+		metaEventBrokerInProcess: func(*Config) (eventBroker, error) { return eventBus, nil },
+	}
+
+	// eventBus is the in-process broker, consumers within the service subscribe to it
+	eventBus = &inProcessBroker{}
+
+	// outboxTransactionsUnsupported is set once mongodb rejected a transaction (standalone server)
+	outboxTransactionsUnsupported int32
+
+	errOutboxNonTransactional = errors.New("transactions not supported (standalone mongodb?), writes without transaction require OUTBOX_ALLOW_NON_TRANSACTIONAL")
+)
+
+// inviteCodeEvent is the JSON payload of invite code events (broker messages and webhooks)
+type inviteCodeEvent struct {
+	ID         string              `json:"id"`
+	Type       string              `json:"type"`
+	OccurredAt time.Time           `json:"occurred_at"`
+	InviteCode inviteCodeEventData `json:"invite_code"`
+}
+
+type inviteCodeEventData struct {
+	ID             string    `json:"id"`
+	MetaCode       string    `json:"meta_code"`
+	MetaForAppRole string    `json:"meta_for_app_role"`
+	MetaValidFrom  time.Time `json:"meta_valid_from"`
+	MetaValidTo    time.Time `json:"meta_valid_to"`
+}
+
+// outboxEvent is written to event_outbox in the transaction of the domain write, it is removed once published
+type outboxEvent struct {
+	ID            primitive.ObjectID `bson:"_id"`
+	Type          string             `bson:"type"`
+	InviteCode    UserInviteCode     `bson:"invite_code"`
+	OccurredAt    time.Time          `bson:"occurred_at"`
+	Attempts      int                `bson:"attempts"`
+	LastError     string             `bson:"last_error"`
+	NextAttemptAt time.Time          `bson:"next_attempt_at"`
+}
+
+// brokerMessage is published per event, brokers partition by key (the invite code id)
+type brokerMessage struct {
+	ID      string
+	Type    string
+	Key     string
+	Payload []byte
+}
+
+// eventBroker publishes the events of the outbox, publish returns once the broker accepted the message
+type eventBroker interface {
+	publish(ctx context.Context, msg brokerMessage) error
+	close() error
+}
+
+type eventBrokerFactory func(cfg *Config) (eventBroker, error)
+
+// inProcessBroker hands messages synchronously to the subscribers of this process
+type inProcessBroker struct {
+	mu          sync.RWMutex
+	subscribers []func(brokerMessage)
+}
+
+// outboxStore queues outbox events, a claimed event is leased (next_attempt_at) until it is removed or rescheduled,
+// so an event of a crashed relay is published by the next one.
+type outboxStore interface {
+	enqueue(ctx context.Context, event *outboxEvent) error
+	claim(ctx context.Context, now time.Time, lease time.Duration) (*outboxEvent, error)
+	remove(ctx context.Context, id primitive.ObjectID) error
+	reschedule(ctx context.Context, event *outboxEvent) error
+}
+
+// outboxRelay publishes queued outbox events to the broker (and the webhook queue), at least once per event
+type outboxRelay struct {
+	store      outboxStore
+	broker     eventBroker
+	webhooks   *webhookDispatcher
+	backoff    time.Duration
+	maxBackoff time.Duration
+	lease      time.Duration
+	now        func() time.Time
+
+	kick      chan struct{}
+	stop      chan struct{}
+	done      chan struct{}
+	started   int32
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+type mongoDbOutboxStore struct {
+	collection func() *mongo.Collection
+}
+
+//
+// -- gRPC Outbox Stack 23/n :: transactional outbox && event relay
+//
+
+// newOutboxRelay returns the relay of the configured broker (OUTBOX_BROKER)
+func newOutboxRelay(cfg *Config, webhooks *webhookDispatcher) (*outboxRelay, error) {
+
+	broker, err := newEventBroker(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &outboxRelay{
+		store:      &mongoDbOutboxStore{collection: func() *mongo.Collection { return mongoDbCurrent().database.Collection(metaOutboxCollectionTbl) }},
+		broker:     broker,
+		webhooks:   webhooks,
+		backoff:    cfg.OutboxBackoff,
+		maxBackoff: cfg.OutboxMaxBackoff,
+		lease:      2 * cfg.OutboxBrokerTimeout,
+		now:        time.Now,
+		kick:       make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}, nil
+}
+
+func registerEventBroker(name string, factory eventBrokerFactory) {
+
+	eventBrokerMu.Lock()
+	eventBrokers[name] = factory
+	eventBrokerMu.Unlock()
+}
+
+func newEventBroker(cfg *Config) (eventBroker, error) {
+
+	eventBrokerMu.RLock()
+	factory, ok := eventBrokers[cfg.OutboxBroker]
+	eventBrokerMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown event broker [%s]", cfg.OutboxBroker)
+	}
+
+	return factory(cfg)
+}
+
+// newInviteCodeEvent returns the event of the given invite code
+func newInviteCodeEvent(id primitive.ObjectID, eventType string, occurredAt time.Time, inviteCode *UserInviteCode) inviteCodeEvent {
+
+	return inviteCodeEvent{ID: id.Hex(), Type: eventType, OccurredAt: occurredAt.UTC(), InviteCode: inviteCodeEventData{
+		ID: inviteCode.ID.Hex(), MetaCode: inviteCode.MetaCode, MetaForAppRole: inviteCode.MetaForAppRole,
+		MetaValidFrom: inviteCode.MetaValidFrom, MetaValidTo: inviteCode.MetaValidTo,
+	}}
+}
+
+// outboxTransaction runs write (the domain write and outboxEnqueue of its events) in one transaction, a crash
+// can't lose the event of a committed write. Standalone servers don't support transactions, writes are rejected
+// there (errOutboxNonTransactional) unless OUTBOX_ALLOW_NON_TRANSACTIONAL opts in to writes without transaction.
+func outboxTransaction(ctx context.Context, write func(ctx context.Context) error) error {
+
+	if atomic.LoadInt32(&outboxTransactionsUnsupported) == 0 {
+		session, err := mongoDbCurrent().client.StartSession()
+		if err != nil {
+			return err
+		}
+		defer session.EndSession(context.Background())
+
+		_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) { return nil, write(sc) })
+		if !_isMongoDbTransactionUnsupported(err) {
+			if err == nil {
+				metaOutbox.notify()
+			}
+			return err
+		}
+
+		outboxSetTransactionsUnsupported(err)
+	}
+
+	if !configCurrent().OutboxAllowNonTransactional {
+		return errOutboxNonTransactional
+	}
+
+	if err := write(ctx); err != nil {
+		return err
+	}
+	metaOutbox.notify()
+
+	return nil
+}
+
+// outboxCheckTransactions detects standalone servers on startup (no replica set, no mongos), a missing opt-in
+// (OUTBOX_ALLOW_NON_TRANSACTIONAL) is logged as error, all writes are rejected then.
+func outboxCheckTransactions(database *mongo.Database) error {
+
+	ctx, cancel := context.WithTimeout(metaMongoDbContext, metaMongoDbIndexTimeout)
+	defer cancel()
+
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	if err := database.RunCommand(ctx, bson.D{{Key: "isMaster", Value: 1}}).Decode(&hello); err != nil {
+		return fmt.Errorf("unable to detect transaction support: %v", err)
+	}
+
+	if hello.SetName != "" || hello.Msg == "isdbgrid" {
+		atomic.StoreInt32(&outboxTransactionsUnsupported, 0)
+		return nil
+	}
+	outboxSetTransactionsUnsupported(errors.New("standalone server"))
+
+	return nil
+}
+
+// outboxSetTransactionsUnsupported marks transactions as unsupported (logged once)
+func outboxSetTransactionsUnsupported(cause error) {
+
+	if !atomic.CompareAndSwapInt32(&outboxTransactionsUnsupported, 0, 1) {
+		return
+	}
+
+	if configCurrent().OutboxAllowNonTransactional {
+		log.Warnf("%s: outbox: transactions not supported (%v), events are written without (OUTBOX_ALLOW_NON_TRANSACTIONAL)",metaServiceName,cause)
+		return
+	}
+
+	log.Errorf("%s: outbox: transactions not supported (%v), invite code writes are rejected, set OUTBOX_ALLOW_NON_TRANSACTIONAL to write events without transaction",metaServiceName,cause)
+}
+
+// outboxEnqueue queues the event of the given invite code, ctx is the context of outboxTransaction
+func outboxEnqueue(ctx context.Context, eventType string, inviteCode *UserInviteCode) error {
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	event := &outboxEvent{ID: primitive.NewObjectID(), Type: eventType, InviteCode: *inviteCode, OccurredAt: now, NextAttemptAt: now}
+
+	if err := metaOutbox.store.enqueue(ctx, event); err != nil {
+		return fmt.Errorf("unable to queue outbox event %s: %v", eventType, err)
+	}
+
+	return nil
+}
+
+// relayDue publishes all due events, failed events are rescheduled with exponential backoff (never dropped)
+func (r *outboxRelay) relayDue(ctx context.Context) (int, error) {
+
+	published := 0
+	for {
+		event, err := r.store.claim(ctx, r.now(), r.lease)
+		if err != nil || event == nil {
+			return published, err
+		}
+
+		if err := r.publish(ctx, event); err != nil {
+			if ctx.Err() != nil {
+				return published, ctx.Err()
+			}
+
+			event.Attempts++
+			event.LastError = err.Error()
+			event.NextAttemptAt = r.now().Add(_getExponentialBackoff(r.backoff, r.maxBackoff, event.Attempts))
+			log.Warnf("%s: outbox: attempt #%d of %s [%s] failed, retry at %s: %v",metaServiceName,event.Attempts,event.Type,event.ID.Hex(),event.NextAttemptAt.Format(time.RFC3339),err)
+
+			if err := r.store.reschedule(ctx, event); err != nil {
+				return published, fmt.Errorf("unable to reschedule outbox event [%s]: %v", event.ID.Hex(), err)
+			}
+			continue
+		}
+
+		if err := r.store.remove(ctx, event.ID); err != nil {
+			return published, fmt.Errorf("unable to remove outbox event [%s]: %v", event.ID.Hex(), err)
+		}
+		published++
+	}
+}
+
+// publish hands the event to the broker and queues its webhooks
+func (r *outboxRelay) publish(ctx context.Context, event *outboxEvent) error {
+
+	payload := newInviteCodeEvent(event.ID, event.Type, event.OccurredAt, &event.InviteCode)
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("unable to encode event: %v", err)
+	}
+
+	if err := r.broker.publish(ctx, brokerMessage{ID: payload.ID, Type: event.Type, Key: payload.InviteCode.ID, Payload: raw}); err != nil {
+		return fmt.Errorf("broker: %v", err)
+	}
+
+	if r.webhooks != nil {
+		if err := r.webhooks.publish(ctx, payload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// start relays due events periodically and right after a write (once per process) until drain is called
+func (r *outboxRelay) start(interval time.Duration) {
+
+	if interval <= 0 {
+		return
+	}
+
+	r.startOnce.Do(func() {
+		atomic.StoreInt32(&r.started, 1)
+		go func() {
+			defer close(r.done)
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-r.stop:
+					return
+				case <-ticker.C:
+				case <-r.kick:
+				}
+
+				relayCtx, cancel := mongoDbOperationContext(context.Background(), 0)
+				if _, err := r.relayDue(relayCtx); err != nil && relayCtx.Err() == nil {
+					log.Warnf("%s: outbox: %v",metaServiceName,err)
+				}
+				cancel()
+			}
+		}()
+	})
+}
+
+// notify wakes up the relay after a committed write
+func (r *outboxRelay) notify() {
+
+	if r == nil {
+		return
+	}
+
+	select {
+	case r.kick <- struct{}{}:
+	default:
+	}
+}
+
+// drain stops the relay and publishes the events written by the calls drained before, events which can't be
+// published within timeout stay queued for the next instance. The broker is closed afterwards.
+func (r *outboxRelay) drain(timeout time.Duration) {
+
+	r.stopOnce.Do(func() { close(r.stop) })
+
+	if atomic.LoadInt32(&r.started) == 1 {
+		deadline := time.NewTimer(timeout)
+		defer deadline.Stop()
+
+		select {
+		case <-r.done:
+		case <-deadline.C:
+			log.Warnf("%s: outbox: relay still busy after %v, remaining events stay queued",metaServiceName,timeout)
+			return
+		}
+
+		drainCtx, cancel := context.WithTimeout(context.Background(), timeout)
+		published, err := r.relayDue(drainCtx)
+		cancel()
+
+		if err != nil {
+			log.Warnf("%s: outbox: drained %d event(s), remaining events stay queued: %v",metaServiceName,published,err)
+		} else {
+			log.Infof("%s: outbox: drained %d event(s)",metaServiceName,published)
+		}
+	}
+
+	if err := r.broker.close(); err != nil {
+		log.Warnf("%s: outbox: unable to close broker: %v",metaServiceName,err)
+	}
+}
+
+func (b *inProcessBroker) publish(_ context.Context, msg brokerMessage) error {
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, subscriber := range b.subscribers {
+		subscriber(msg)
+	}
+
+	return nil
+}
+
+func (b *inProcessBroker) close() error {
+	return nil
+}
+
+// subscribe registers a consumer of all events published in this process
+func (b *inProcessBroker) subscribe(subscriber func(brokerMessage)) {
+
+	b.mu.Lock()
+	b.subscribers = append(b.subscribers, subscriber)
+	b.mu.Unlock()
+}
+
+func (s *mongoDbOutboxStore) enqueue(ctx context.Context, event *outboxEvent) error {
+
+	_, err := s.collection().InsertOne(ctx, event)
+
+	return err
+}
+
+func (s *mongoDbOutboxStore) claim(ctx context.Context, now time.Time, lease time.Duration) (*outboxEvent, error) {
+
+	event := &outboxEvent{}
+	err := s.collection().FindOneAndUpdate(ctx,
+		bson.M{"next_attempt_at": bson.M{"$lte": now}},
+		bson.M{"$set": bson.M{"next_attempt_at": now.Add(lease)}},
+		options.FindOneAndUpdate().SetSort(bson.D{{Key: "next_attempt_at", Value: 1}, {Key: "_id", Value: 1}}).SetReturnDocument(options.After),
+	).Decode(event)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	return event, err
+}
+
+func (s *mongoDbOutboxStore) remove(ctx context.Context, id primitive.ObjectID) error {
+
+	_, err := s.collection().DeleteOne(ctx, bson.M{"_id": id})
+
+	return err
+}
+
+func (s *mongoDbOutboxStore) reschedule(ctx context.Context, event *outboxEvent) error {
+
+	_, err := s.collection().UpdateOne(ctx, bson.M{"_id": event.ID}, bson.M{"$set": bson.M{
+		"attempts":        event.Attempts,
+		"last_error":      event.LastError,
+		"next_attempt_at": event.NextAttemptAt,
+	}})
+
+	return err
+}
+
+// _validateOutbox returns all outbox configuration errors
+func (c *Config) _validateOutbox() []string {
+
+	var errs []string
+	eventBrokerMu.RLock()
+	_, ok := eventBrokers[c.OutboxBroker]
+	eventBrokerMu.RUnlock()
+	if !ok {
+		errs = append(errs, fmt.Sprintf("OUTBOX_BROKER: must be one of [%s], got %s", _getEventBrokerNames(), c.OutboxBroker))
+	}
+
+	if c.OutboxBroker != metaEventBrokerInProcess && c.OutboxBrokerURL == "" {
+		errs = append(errs, fmt.Sprintf("OUTBOX_BROKER_URL: must be set for broker %s", c.OutboxBroker))
+	}
+
+	if c.OutboxBackoff <= 0 || c.OutboxMaxBackoff < c.OutboxBackoff {
+		errs = append(errs, fmt.Sprintf("OUTBOX_BACKOFF/OUTBOX_MAX_BACKOFF: must be positive and max >= initial, got %v/%v", c.OutboxBackoff, c.OutboxMaxBackoff))
+	}
+
+	if c.OutboxBrokerTimeout <= 0 {
+		errs = append(errs, fmt.Sprintf("OUTBOX_BROKER_TIMEOUT: must be positive, got %v", c.OutboxBrokerTimeout))
+	}
+
+	if c.OutboxPollInterval < 0 {
+		errs = append(errs, fmt.Sprintf("OUTBOX_POLL_INTERVAL: must not be negative, got %v", c.OutboxPollInterval))
+	}
+
+	return errs
+}
+
+//
+// -- sidekick stack for outbox helper methods
+//
+
+// _isMongoDbTransactionUnsupported reports errors of transactions on standalone servers (IllegalOperation)
+func _isMongoDbTransactionUnsupported(err error) bool {
+
+	var cmdErr mongo.CommandError
+	if err == nil || !errors.As(err, &cmdErr) {
+		return false
+	}
+
+	return cmdErr.Code == 20 && strings.Contains(cmdErr.Message, "Transaction numbers")
+}
+
+func _getEventBrokerNames() string {
+
+	eventBrokerMu.RLock()
+	defer eventBrokerMu.RUnlock()
+
+	var names []string
+	for name := range eventBrokers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ", ")
+}
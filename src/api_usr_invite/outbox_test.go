@@ -0,0 +1,297 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// outboxTestStore queues outbox events in memory
+type outboxTestStore struct {
+	mu     sync.Mutex
+	events []outboxEvent
+}
+
+// outboxTestBroker records published messages, publish fails with err if set
+type outboxTestBroker struct {
+	mu       sync.Mutex
+	messages []brokerMessage
+	err      error
+	closed   bool
+}
+
+//
+// -- core test helper methods :: *.n
+//
+
+func (s *outboxTestStore) enqueue(_ context.Context, event *outboxEvent) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, *event)
+
+	return nil
+}
+
+func (s *outboxTestStore) claim(_ context.Context, now time.Time, lease time.Duration) (*outboxEvent, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, event := range s.events {
+		if !event.NextAttemptAt.After(now) {
+			s.events[i].NextAttemptAt = now.Add(lease)
+			claimed := s.events[i]
+			return &claimed, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *outboxTestStore) remove(_ context.Context, id primitive.ObjectID) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, event := range s.events {
+		if event.ID == id {
+			s.events = append(s.events[:i], s.events[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (s *outboxTestStore) reschedule(_ context.Context, event *outboxEvent) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.events {
+		if s.events[i].ID == event.ID {
+			s.events[i] = *event
+		}
+	}
+
+	return nil
+}
+
+func (s *outboxTestStore) pending() int {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.events)
+}
+
+func (b *outboxTestBroker) publish(_ context.Context, msg brokerMessage) error {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.err != nil {
+		return b.err
+	}
+	b.messages = append(b.messages, msg)
+
+	return nil
+}
+
+func (b *outboxTestBroker) close() error {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+
+	return nil
+}
+
+func outboxTestRelay(now func() time.Time) (*outboxRelay, *outboxTestStore, *outboxTestBroker) {
+
+	store, broker := &outboxTestStore{}, &outboxTestBroker{}
+
+	return &outboxRelay{
+		store: store, broker: broker, backoff: time.Second, maxBackoff: time.Minute, lease: 10 * time.Second, now: now,
+		kick: make(chan struct{}, 1), stop: make(chan struct{}), done: make(chan struct{}),
+	}, store, broker
+}
+
+func outboxTestEvent(eventType string, at time.Time) *outboxEvent {
+
+	inviteCode := UserInviteCode{ID: primitive.NewObjectID(), MetaCode: "outbox", MetaForAppRole: "teacher", MetaValidTo: at.Add(time.Hour)}
+
+	return &outboxEvent{ID: primitive.NewObjectID(), Type: eventType, InviteCode: inviteCode, OccurredAt: at, NextAttemptAt: at}
+}
+
+//
+// -- core test methods :: transactional outbox
+//
+
+func TestOutbox_RelayPublishes(t *testing.T) {
+
+	now := time.Now()
+	relay, store, broker := outboxTestRelay(func() time.Time { return now })
+	webhooks, deliveries := webhookTestDispatcher(now, webhookEndpoint{Name: "all", URL: "http://localhost"})
+	relay.webhooks = webhooks
+
+	created, deleted := outboxTestEvent(metaInviteCodeEventCreated, now), outboxTestEvent(metaInviteCodeEventDeleted, now)
+	assert.NoError(t, store.enqueue(ctx, created))
+	assert.NoError(t, store.enqueue(ctx, deleted))
+
+	published, err := relay.relayDue(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, published)
+	assert.Zero(t, store.pending(), "published events are removed")
+
+	assert.Len(t, broker.messages, 2)
+	assert.Equal(t, created.ID.Hex(), broker.messages[0].ID)
+	assert.Equal(t, metaInviteCodeEventCreated, broker.messages[0].Type)
+	assert.Equal(t, created.InviteCode.ID.Hex(), broker.messages[0].Key)
+
+	event := inviteCodeEvent{}
+	assert.NoError(t, json.Unmarshal(broker.messages[1].Payload, &event))
+	assert.Equal(t, deleted.ID.Hex(), event.ID)
+	assert.Equal(t, "outbox", event.InviteCode.MetaCode)
+
+	// webhooks of an event share the event id with the broker message
+	assert.Len(t, deliveries.deliveries, 2)
+	assert.Equal(t, created.ID.Hex(), deliveries.deliveries[0].EventID)
+}
+
+func TestOutbox_RelayRetries(t *testing.T) {
+
+	now := time.Now()
+	relay, store, broker := outboxTestRelay(func() time.Time { return now })
+	broker.err = errors.New("broker down")
+	assert.NoError(t, store.enqueue(ctx, outboxTestEvent(metaInviteCodeEventCreated, now)))
+
+	published, err := relay.relayDue(ctx)
+	assert.NoError(t, err)
+	assert.Zero(t, published)
+	assert.Equal(t, 1, store.events[0].Attempts)
+	assert.Equal(t, "broker: broker down", store.events[0].LastError)
+	assert.Equal(t, now.Add(time.Second), store.events[0].NextAttemptAt)
+
+	now = now.Add(time.Second)
+	_, _ = relay.relayDue(ctx)
+	assert.Equal(t, now.Add(2*time.Second), store.events[0].NextAttemptAt, "backoff doubled")
+
+	// events are never dropped, they are published once the broker is back
+	broker.err = nil
+	now = now.Add(2 * time.Second)
+	published, err = relay.relayDue(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Zero(t, store.pending())
+}
+
+func TestOutbox_RelayDrain(t *testing.T) {
+
+	relay, store, broker := outboxTestRelay(time.Now)
+	relay.start(time.Hour)
+
+	// writes wake up the relay
+	assert.NoError(t, store.enqueue(ctx, outboxTestEvent(metaInviteCodeEventCreated, time.Now())))
+	relay.notify()
+	assert.Eventually(t, func() bool { return store.pending() == 0 }, 5*time.Second, 10*time.Millisecond)
+
+	// events written by drained calls are published before the relay stops
+	assert.NoError(t, store.enqueue(ctx, outboxTestEvent(metaInviteCodeEventDeleted, time.Now())))
+	relay.drain(time.Second)
+	assert.Zero(t, store.pending())
+	assert.Len(t, broker.messages, 2)
+	assert.True(t, broker.closed)
+
+	relay.drain(time.Second)
+	relay.notify()
+
+	// a relay which was never started closes the broker only
+	relay, store, broker = outboxTestRelay(time.Now)
+	assert.NoError(t, store.enqueue(ctx, outboxTestEvent(metaInviteCodeEventCreated, time.Now())))
+	relay.drain(time.Second)
+	assert.Equal(t, 1, store.pending())
+	assert.True(t, broker.closed)
+}
+
+func TestOutbox_InProcessBroker(t *testing.T) {
+
+	broker, err := newEventBroker(&Config{OutboxBroker: metaEventBrokerInProcess})
+	if err != nil { t.Fatal(err) }
+
+	var received []brokerMessage
+	eventBus.subscribe(func(msg brokerMessage) { received = append(received, msg) })
+	assert.NoError(t, broker.publish(ctx, brokerMessage{ID: "1", Type: metaInviteCodeEventCreated}))
+	assert.Len(t, received, 1)
+	assert.NoError(t, broker.close())
+
+	_, err = newEventBroker(&Config{OutboxBroker: "pigeon"})
+	assert.Error(t, err)
+}
+
+func TestOutbox_TransactionsUnsupported(t *testing.T) {
+
+	assert.True(t, _isMongoDbTransactionUnsupported(mongo.CommandError{Code: 20, Message: "Transaction numbers are only allowed on a replica set member or mongos"}))
+	assert.False(t, _isMongoDbTransactionUnsupported(mongo.CommandError{Code: 20, Message: "Illegal operation"}))
+	assert.False(t, _isMongoDbTransactionUnsupported(mongo.ErrNoDocuments))
+	assert.False(t, _isMongoDbTransactionUnsupported(nil))
+}
+
+func TestOutbox_NonTransactionalOptIn(t *testing.T) {
+
+	previous, allowed := atomic.LoadInt32(&outboxTransactionsUnsupported), configCurrent().OutboxAllowNonTransactional
+	defer func() { atomic.StoreInt32(&outboxTransactionsUnsupported, previous); configCurrent().OutboxAllowNonTransactional = allowed }()
+
+	writes := 0
+	write := func(context.Context) error { writes++; return nil }
+
+	// without opt-in writes are rejected once transactions are known to be unsupported
+	atomic.StoreInt32(&outboxTransactionsUnsupported, 0)
+	configCurrent().OutboxAllowNonTransactional = false
+	outboxSetTransactionsUnsupported(errors.New("standalone server"))
+	assert.Equal(t, errOutboxNonTransactional, outboxTransaction(ctx, write))
+	assert.Zero(t, writes)
+
+	configCurrent().OutboxAllowNonTransactional = true
+	assert.NoError(t, outboxTransaction(ctx, write))
+	assert.Equal(t, 1, writes)
+}
+
+func TestOutbox_Config(t *testing.T) {
+
+	cfg := &Config{OutboxBroker: "pigeon", OutboxBackoff: time.Second, OutboxMaxBackoff: time.Second, OutboxBrokerTimeout: time.Second}
+	errs := cfg._validateOutbox()
+	assert.Len(t, errs, 2)
+	assert.Contains(t, errs[0], "must be one of [inprocess, kafka-rest, nats]")
+
+	cfg = &Config{OutboxBroker: metaEventBrokerNats, OutboxBrokerURL: "nats://localhost", OutboxBackoff: time.Second, OutboxMaxBackoff: time.Millisecond, OutboxPollInterval: -time.Second}
+	assert.Len(t, cfg._validateOutbox(), 3)
+
+	cfg = &Config{OutboxBroker: metaEventBrokerInProcess, OutboxBackoff: time.Second, OutboxMaxBackoff: time.Minute, OutboxBrokerTimeout: time.Second}
+	assert.Empty(t, cfg._validateOutbox())
+}
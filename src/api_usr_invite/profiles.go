@@ -0,0 +1,376 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/oklog/ulid/v2"
+	"gopkg.in/yaml.v2"
+	"hash/fnv"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	metaFixtureProfileDefault    = "default"
+	metaFixtureCodeFormatDefault = "{ulid}"
+	metaFixtureMaxRoleCount      = 100000
+)
+
+// fixtureValidity describes the validity window of generated codes relative to their creation time,
+// valid_from = created_at + from_offset + [0, from_jitter) and valid_to = valid_from + duration.
+type fixtureValidity struct {
+	FromOffset time.Duration `yaml:"from_offset" json:"from_offset"`
+	FromJitter time.Duration `yaml:"from_jitter" json:"from_jitter"`
+	Duration   time.Duration `yaml:"duration" json:"duration"`
+}
+
+type fixtureRoleProfile struct {
+	Role     string           `yaml:"role" json:"role"`
+	Count    int              `yaml:"count" json:"count"`
+	Validity *fixtureValidity `yaml:"validity,omitempty" json:"validity,omitempty"`
+}
+
+// fixtureProfile is a named, declarative fixture set (e.g. dev, loadtest, e2e), a seed other than 0
+// switches to the seeded mode: codes and ids are derived from seed and epoch only, the timestamps
+// as well if an epoch is set explicitly.
+type fixtureProfile struct {
+	Name       string               `yaml:"-" json:"-"`
+	Seed       int64                `yaml:"seed" json:"seed"`
+	Epoch      *time.Time           `yaml:"epoch,omitempty" json:"epoch,omitempty"`
+	Wipe       bool                 `yaml:"wipe" json:"wipe"`
+	WipeTest   bool                 `yaml:"wipe_test" json:"wipe_test"`
+	CodeFormat string               `yaml:"code_format" json:"code_format"`
+	Validity   fixtureValidity      `yaml:"validity" json:"validity"`
+	Roles      []fixtureRoleProfile `yaml:"roles" json:"roles"`
+}
+
+type fixtureProfileSet struct {
+	Default  string                     `yaml:"default" json:"default"`
+	Profiles map[string]*fixtureProfile `yaml:"profiles" json:"profiles"`
+}
+
+// fixtureProfileStore holds all known fixture profiles, the built-in default profile is always
+// available and may be overridden by a profile of the same name in the profile file.
+type fixtureProfileStore struct {
+	mu       sync.RWMutex
+	file     string
+	active   string
+	profiles map[string]*fixtureProfile
+}
+
+// fixtureGenerator renders the invite codes of a single profile run, all random values (ulid entropy,
+// jitter, object id) of a code are drawn from a source derived from seed, role and sequence number,
+// so adding a role or changing a count never changes the codes of the other roles.
+type fixtureGenerator struct {
+	profile       *fixtureProfile
+	seed          int64
+	deterministic bool
+	epoch         time.Time
+	now           time.Time
+}
+
+// metaFixtureEpoch is the reference time of seeded codes and ids without an explicit profile epoch
+var metaFixtureEpoch = time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)
+
+// fixtureDefaultProfile mirrors the former hard-coded fixture set (wipe fixtures first, 1 year validity)
+var fixtureDefaultProfile = &fixtureProfile{
+	Name:       metaFixtureProfileDefault,
+	Wipe:       true,
+	CodeFormat: metaFixtureCodeFormatDefault,
+	Validity:   fixtureValidity{FromJitter: time.Minute, Duration: time.Hour * 8760},
+	Roles: []fixtureRoleProfile{
+		{Role: "admin", Count: 10},
+		{Role: "director", Count: 3},
+		{Role: "teacher", Count: 99},
+		{Role: "viewer", Count: 5},
+	},
+}
+
+//
+// -- gRPC Fixture Stack 8/n :: declarative fixture profiles
+//
+
+// newFixtureProfileStore loads the (optional) profile file, active names the profile used for
+// seeding runs without an explicit profile (SIGUSR1), it falls back to the file default.
+func newFixtureProfileStore(file string, active string) (*fixtureProfileStore, error) {
+
+	s := &fixtureProfileStore{file: file, active: active}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.get(""); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// loadFixtureProfileSet reads a YAML (or JSON, being a subset of YAML) profile file, unknown keys
+// are rejected to catch typos early.
+func loadFixtureProfileSet(file string) (*fixtureProfileSet, error) {
+
+	raw, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read fixture profile file [%s]: %v", file, err)
+	}
+
+	set := &fixtureProfileSet{}
+	if err := yaml.UnmarshalStrict(raw, set); err != nil {
+		return nil, fmt.Errorf("unable to parse fixture profile file [%s]: %v", file, err)
+	}
+
+	for name, profile := range set.Profiles {
+		if profile == nil {
+			return nil, fmt.Errorf("invalid fixture profile [%s] in [%s]: empty profile", name, file)
+		}
+		profile.Name = name
+		if profile.CodeFormat == "" {
+			profile.CodeFormat = metaFixtureCodeFormatDefault
+		}
+		if err := profile.validate(); err != nil {
+			return nil, fmt.Errorf("invalid fixture profile [%s] in [%s]: %v", name, file, err)
+		}
+	}
+
+	if set.Default != "" && set.Profiles[set.Default] == nil && set.Default != metaFixtureProfileDefault {
+		return nil, fmt.Errorf("unknown default fixture profile [%s] in [%s]", set.Default, file)
+	}
+
+	return set, nil
+}
+
+// reload re-reads the profile file (if any), the current profiles stay active if the file is broken.
+func (s *fixtureProfileStore) reload() error {
+
+	profiles := map[string]*fixtureProfile{metaFixtureProfileDefault: fixtureDefaultProfile}
+	active := s.active
+
+	if s.file != "" {
+		set, err := loadFixtureProfileSet(s.file)
+		if err != nil {
+			return err
+		}
+		for name, profile := range set.Profiles {
+			profiles[name] = profile
+		}
+		if active == "" {
+			active = set.Default
+		}
+	}
+
+	if active == "" {
+		active = metaFixtureProfileDefault
+	}
+
+	s.mu.Lock()
+	s.profiles, s.active = profiles, active
+	s.mu.Unlock()
+
+	return nil
+}
+
+// get returns the named profile, an empty name resolves to the active (default) profile.
+func (s *fixtureProfileStore) get(name string) (*fixtureProfile, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if name == "" {
+		name = s.active
+	}
+
+	profile, ok := s.profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown fixture profile [%s], available: %s", name, strings.Join(s._names(), ", "))
+	}
+
+	return profile, nil
+}
+
+func (s *fixtureProfileStore) names() []string {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s._names()
+}
+
+func (s *fixtureProfileStore) _names() []string {
+
+	names := make([]string, 0, len(s.profiles))
+	for name := range s.profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (p *fixtureProfile) validate() error {
+
+	var errs []string
+	if len(p.Roles) == 0 {
+		errs = append(errs, "no roles defined")
+	}
+
+	if !strings.Contains(p.CodeFormat, "{ulid}") && !(strings.Contains(p.CodeFormat, "{role}") && strings.Contains(p.CodeFormat, "{seq}")) {
+		errs = append(errs, fmt.Sprintf("code_format [%s] must contain {ulid} or both {role} and {seq}", p.CodeFormat))
+	}
+
+	if err := p.Validity.validate(); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	for i, role := range p.Roles {
+		if role.Role == "" {
+			errs = append(errs, fmt.Sprintf("role #%d has no name", i))
+		}
+		if role.Count < 0 || role.Count > metaFixtureMaxRoleCount {
+			errs = append(errs, fmt.Sprintf("role [%s] count must be within [0,%d], got %d", role.Role, metaFixtureMaxRoleCount, role.Count))
+		}
+		if role.Validity != nil {
+			if err := role.Validity.validate(); err != nil {
+				errs = append(errs, fmt.Sprintf("role [%s] %v", role.Role, err))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+func (v *fixtureValidity) validate() error {
+
+	if v.Duration <= 0 {
+		return fmt.Errorf("validity duration must be positive, got %v", v.Duration)
+	}
+
+	if v.FromJitter < 0 {
+		return fmt.Errorf("validity from_jitter must not be negative, got %v", v.FromJitter)
+	}
+
+	return nil
+}
+
+func (p *fixtureProfile) total() int {
+
+	total := 0
+	for _, role := range p.Roles {
+		total += role.Count
+	}
+
+	return total
+}
+
+// newFixtureGenerator prepares a generator run of the given profile, seeded profiles derive codes and
+// ids from the profile epoch (default metaFixtureEpoch), all others from now using a time based seed.
+// Validity and creation time are relative to now unless the seeded profile sets an explicit epoch, a
+// fixed default epoch would seed codes which are expired already. All timestamps are truncated to
+// milliseconds (mongodb date precision).
+func newFixtureGenerator(profile *fixtureProfile, now time.Time) *fixtureGenerator {
+
+	g := &fixtureGenerator{profile: profile, seed: profile.Seed, epoch: now, now: now}
+	if profile.Seed != 0 {
+		g.deterministic, g.epoch = true, metaFixtureEpoch
+		if profile.Epoch != nil {
+			g.epoch, g.now = *profile.Epoch, *profile.Epoch
+		}
+	} else {
+		g.seed = now.UnixNano()
+	}
+
+	g.epoch = g.epoch.UTC().Truncate(time.Millisecond)
+	g.now = g.now.UTC().Truncate(time.Millisecond)
+
+	return g
+}
+
+// next renders the seq'th (1-based) invite code of the given role
+func (g *fixtureGenerator) next(role fixtureRoleProfile, seq int) (UserInviteCode, error) {
+
+	validity := g.profile.Validity
+	if role.Validity != nil {
+		validity = *role.Validity
+	}
+
+	random := g._random(role.Role, seq)
+	code, err := g._renderCode(role.Role, seq, random)
+	if err != nil {
+		return UserInviteCode{}, err
+	}
+
+	validFrom := g.now.Add(validity.FromOffset)
+	if validity.FromJitter > 0 {
+		validFrom = validFrom.Add(time.Duration(random.Int63n(int64(validity.FromJitter)))).Truncate(time.Millisecond)
+	}
+
+	inviteCode := UserInviteCode{
+		IsFixture: true,
+		MetaCode: code,
+		MetaForAppRole: role.Role,
+		MetaValidFrom: validFrom,
+		MetaValidTo: validFrom.Add(validity.Duration),
+		CreatedAt: g.now,
+		Version: metaInviteCodeInitialVersion,
+	}
+
+	// seeded runs use derived object ids as well, otherwise mongodb generates them on insert
+	if g.deterministic {
+		binary.BigEndian.PutUint32(inviteCode.ID[0:4], uint32(g.epoch.Unix()))
+		_, _ = random.Read(inviteCode.ID[4:])
+	}
+
+	return inviteCode, nil
+}
+
+func (g *fixtureGenerator) _random(role string, seq int) *rand.Rand {
+
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "%s/%d", role, seq)
+
+	return rand.New(rand.NewSource(g.seed ^ int64(h.Sum64())))
+}
+
+func (g *fixtureGenerator) _renderCode(role string, seq int, random io.Reader) (string, error) {
+
+	format := g.profile.CodeFormat
+	values := []string{"{role}", role, "{seq}", strconv.Itoa(seq), "{profile}", g.profile.Name}
+
+	if strings.Contains(format, "{ulid}") {
+		id, err := ulid.New(ulid.Timestamp(g.epoch), random)
+		if err != nil {
+			return "", err
+		}
+		values = append(values, "{ulid}", id.String())
+	}
+
+	return strings.NewReplacer(values...).Replace(format), nil
+}
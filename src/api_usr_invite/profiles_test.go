@@ -0,0 +1,247 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+const profileTestYAMLFile = `
+default: dev
+profiles:
+  dev:
+    wipe: true
+    validity: { from_jitter: 60s, duration: 720h }
+    roles:
+      - { role: admin, count: 2 }
+      - { role: viewer, count: 3 }
+  e2e:
+    seed: 42
+    code_format: "E2E-{role}-{seq}"
+    validity: { duration: 24h }
+    roles:
+      - { role: teacher, count: 2 }
+      - { role: viewer, count: 1, validity: { from_offset: -48h, duration: 24h } }
+`
+
+const profileTestJSONFile = `{
+  "profiles": {
+    "loadtest": { "seed": 7, "validity": { "duration": "8760h" }, "roles": [ { "role": "teacher", "count": 5000 } ] }
+  }
+}`
+
+const profileTestGoldenFile = `
+profiles:
+  golden:
+    seed: 20201101
+    epoch: 2020-06-01T12:00:00Z
+    validity: { from_offset: 1h, from_jitter: 60s, duration: 8760h }
+    roles:
+      - { role: admin, count: 2 }
+      - { role: viewer, count: 2 }
+`
+
+const profileTestGoldenPath = "testdata/fixture_profile_golden.json"
+
+var profileTestUpdateGolden = flag.Bool("update", false, "update the golden fixture files in testdata")
+
+//
+// -- core test helper methods :: *.n
+//
+
+func profileTestStore(t *testing.T, pattern string, content string, active string) (*fixtureProfileStore, error) {
+
+	file, err := ioutil.TempFile("", pattern)
+	if err != nil { t.Fatal(err) }
+	defer os.Remove(file.Name())
+
+	_, _ = file.WriteString(content); _ = file.Close()
+
+	return newFixtureProfileStore(file.Name(), active)
+}
+
+func profileTestGenerate(t *testing.T, profile *fixtureProfile, now time.Time) []UserInviteCode {
+
+	var codes []UserInviteCode
+	g := newFixtureGenerator(profile, now)
+	for _, role := range profile.Roles {
+		for seq := 1; seq <= role.Count; seq++ {
+			code, err := g.next(role, seq)
+			if err != nil { t.Fatal(err) }
+			codes = append(codes, code)
+		}
+	}
+
+	return codes
+}
+
+//
+// -- core test methods :: fixture profiles
+//
+
+func TestFixtureProfiles_LoadYAML(t *testing.T) {
+
+	s, err := profileTestStore(t, "profiles-*.yaml", profileTestYAMLFile, "")
+	if err != nil { t.Fatal(err) }
+
+	assert.Equal(t, []string{"default", "dev", "e2e"}, s.names())
+
+	active, err := s.get("")
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, "dev", active.Name)
+	assert.Equal(t, metaFixtureCodeFormatDefault, active.CodeFormat)
+	assert.Equal(t, 720*time.Hour, active.Validity.Duration)
+	assert.Equal(t, 5, active.total())
+
+	e2e, err := s.get("e2e")
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, int64(42), e2e.Seed)
+	assert.False(t, e2e.Wipe)
+	assert.Equal(t, -48*time.Hour, e2e.Roles[1].Validity.FromOffset)
+}
+
+func TestFixtureProfiles_LoadJSON(t *testing.T) {
+
+	s, err := profileTestStore(t, "profiles-*.json", profileTestJSONFile, "loadtest")
+	if err != nil { t.Fatal(err) }
+
+	loadtest, err := s.get("")
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, 5000, loadtest.total())
+
+	// the built-in profile is still available next to the file profiles
+	builtin, err := s.get(metaFixtureProfileDefault)
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, 117, builtin.total())
+}
+
+func TestFixtureProfiles_Invalid(t *testing.T) {
+
+	_, err := profileTestStore(t, "profiles-*.yaml", `
+profiles:
+  broken:
+    code_format: "{role}"
+    roles: [ { role: admin, count: -1 } ]
+`, "")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "code_format [{role}] must contain")
+	assert.Contains(t, err.Error(), "count must be within")
+	assert.Contains(t, err.Error(), "validity duration must be positive")
+
+	_, err = profileTestStore(t, "profiles-*.yaml", "profiles: { dev: { rolez: [] } }", "")
+	assert.Error(t, err)
+
+	_, err = profileTestStore(t, "profiles-*.yaml", profileTestYAMLFile, "staging")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown fixture profile [staging]")
+}
+
+func TestFixtureProfiles_DefaultWithoutFile(t *testing.T) {
+
+	s, err := newFixtureProfileStore("", "")
+	if err != nil { t.Fatal(err) }
+
+	profile, err := s.get("")
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, fixtureDefaultProfile, profile)
+
+	_, err = s.get("e2e")
+	assert.Error(t, err)
+}
+
+func TestFixtureGenerator_SeededProfile(t *testing.T) {
+
+	s, err := profileTestStore(t, "profiles-*.yaml", profileTestYAMLFile, "")
+	if err != nil { t.Fatal(err) }
+
+	e2e, _ := s.get("e2e")
+
+	now := time.Now()
+	g := newFixtureGenerator(e2e, now)
+	assert.Equal(t, int64(42), g.seed)
+
+	// without an explicit epoch the codes are valid relative to now, ids still derive from the default epoch
+	created := now.UTC().Truncate(time.Millisecond)
+
+	teacher, err := g.next(e2e.Roles[0], 2)
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, "E2E-teacher-2", teacher.MetaCode)
+	assert.True(t, teacher.IsFixture)
+	assert.False(t, teacher.ID.IsZero())
+	assert.Equal(t, metaFixtureEpoch.Unix(), teacher.ID.Timestamp().Unix())
+	assert.Equal(t, created, teacher.CreatedAt)
+	assert.Equal(t, created, teacher.MetaValidFrom)
+	assert.Equal(t, created.Add(24*time.Hour), teacher.MetaValidTo)
+	assert.True(t, teacher.MetaValidTo.After(now))
+
+	// role validity overrides the profile validity (e.g. already expired codes)
+	viewer, _ := g.next(e2e.Roles[1], 1)
+	assert.True(t, viewer.MetaValidTo.Before(now))
+
+	// the codes do not depend on the wall clock
+	later, _ := newFixtureGenerator(e2e, now.Add(time.Hour)).next(e2e.Roles[0], 2)
+	assert.Equal(t, teacher.ID, later.ID)
+	assert.Equal(t, teacher.MetaCode, later.MetaCode)
+}
+
+func TestFixtureGenerator_UnseededProfile(t *testing.T) {
+
+	now := time.Now()
+	profile := &fixtureProfile{CodeFormat: "{ulid}", Validity: fixtureValidity{FromJitter: time.Minute, Duration: time.Hour}}
+
+	g := newFixtureGenerator(profile, now)
+	assert.Equal(t, now.UnixNano(), g.seed)
+
+	code, err := g.next(fixtureRoleProfile{Role: "admin", Count: 1}, 1)
+	if err != nil { t.Fatal(err) }
+	assert.True(t, code.ID.IsZero())
+	assert.Len(t, code.MetaCode, 26)
+	assert.Equal(t, now.UTC().Truncate(time.Millisecond), code.CreatedAt)
+	assert.True(t, code.MetaValidFrom.Sub(code.CreatedAt) < time.Minute)
+}
+
+func TestFixtureGenerator_Reproducible(t *testing.T) {
+
+	s, err := profileTestStore(t, "profiles-*.yaml", profileTestGoldenFile, "")
+	if err != nil { t.Fatal(err) }
+
+	golden, _ := s.get("golden")
+
+	// codes of a role must neither depend on the wall clock nor on the other roles of the profile
+	first := profileTestGenerate(t, golden, time.Now())
+	second := profileTestGenerate(t, &fixtureProfile{Name: golden.Name, Seed: golden.Seed, Epoch: golden.Epoch,
+		CodeFormat: golden.CodeFormat, Validity: golden.Validity, Roles: golden.Roles[1:]}, time.Now().Add(time.Hour))
+	assert.Equal(t, first[len(first)-len(second):], second)
+
+	raw, err := json.MarshalIndent(first, "", "  ")
+	if err != nil { t.Fatal(err) }
+
+	if *profileTestUpdateGolden {
+		if err := ioutil.WriteFile(profileTestGoldenPath, append(raw, '\n'), 0644); err != nil { t.Fatal(err) }
+	}
+
+	expected, err := ioutil.ReadFile(profileTestGoldenPath)
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, string(expected), string(raw)+"\n")
+}
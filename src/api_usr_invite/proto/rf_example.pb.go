@@ -28,6 +28,9 @@ import (
 	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -93,7 +96,120 @@ func (x UserProfile_PhoneType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UserProfile_PhoneType.Descriptor instead.
 func (UserProfile_PhoneType) EnumDescriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{8, 0}
+	return file_rf_example_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type WatchInviteCodesRes_EventType int32
+
+const (
+	WatchInviteCodesRes_EVENT_TYPE_UNSPECIFIED WatchInviteCodesRes_EventType = 0
+	WatchInviteCodesRes_CREATED                WatchInviteCodesRes_EventType = 1
+	WatchInviteCodesRes_UPDATED                WatchInviteCodesRes_EventType = 2
+	WatchInviteCodesRes_DELETED                WatchInviteCodesRes_EventType = 3
+	WatchInviteCodesRes_REDEEMED               WatchInviteCodesRes_EventType = 4 // reserved for code redemption
+)
+
+// Enum value maps for WatchInviteCodesRes_EventType.
+var (
+	WatchInviteCodesRes_EventType_name = map[int32]string{
+		0: "EVENT_TYPE_UNSPECIFIED",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "DELETED",
+		4: "REDEEMED",
+	}
+	WatchInviteCodesRes_EventType_value = map[string]int32{
+		"EVENT_TYPE_UNSPECIFIED": 0,
+		"CREATED":                1,
+		"UPDATED":                2,
+		"DELETED":                3,
+		"REDEEMED":               4,
+	}
+)
+
+func (x WatchInviteCodesRes_EventType) Enum() *WatchInviteCodesRes_EventType {
+	p := new(WatchInviteCodesRes_EventType)
+	*p = x
+	return p
+}
+
+func (x WatchInviteCodesRes_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchInviteCodesRes_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rf_example_proto_enumTypes[1].Descriptor()
+}
+
+func (WatchInviteCodesRes_EventType) Type() protoreflect.EnumType {
+	return &file_rf_example_proto_enumTypes[1]
+}
+
+func (x WatchInviteCodesRes_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchInviteCodesRes_EventType.Descriptor instead.
+func (WatchInviteCodesRes_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{35, 0}
+}
+
+type AuditEvent_Operation int32
+
+const (
+	AuditEvent_OPERATION_UNSPECIFIED AuditEvent_Operation = 0
+	AuditEvent_CREATE                AuditEvent_Operation = 1
+	AuditEvent_UPDATE                AuditEvent_Operation = 2
+	AuditEvent_DELETE                AuditEvent_Operation = 3
+	AuditEvent_RESTORE               AuditEvent_Operation = 4 // reserved, there is no restore operation yet
+	AuditEvent_REDEEM                AuditEvent_Operation = 5 // reserved, there is no redeem operation yet
+)
+
+// Enum value maps for AuditEvent_Operation.
+var (
+	AuditEvent_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "CREATE",
+		2: "UPDATE",
+		3: "DELETE",
+		4: "RESTORE",
+		5: "REDEEM",
+	}
+	AuditEvent_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"CREATE":                1,
+		"UPDATE":                2,
+		"DELETE":                3,
+		"RESTORE":               4,
+		"REDEEM":                5,
+	}
+)
+
+func (x AuditEvent_Operation) Enum() *AuditEvent_Operation {
+	p := new(AuditEvent_Operation)
+	*p = x
+	return p
+}
+
+func (x AuditEvent_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditEvent_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_rf_example_proto_enumTypes[2].Descriptor()
+}
+
+func (AuditEvent_Operation) Type() protoreflect.EnumType {
+	return &file_rf_example_proto_enumTypes[2]
+}
+
+func (x AuditEvent_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditEvent_Operation.Descriptor instead.
+func (AuditEvent_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{53, 0}
 }
 
 type Empty struct {
@@ -134,6 +250,103 @@ func (*Empty) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{0}
 }
 
+// FieldRules are declarative constraints of request fields, enforced by the validation interceptor of a service and
+// reported as google.rpc.BadRequest field violations (e.g. `string id = 1 [(rules) = {object_id: true}];`).
+type FieldRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Required    bool   `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`                          // strings must not be empty, messages (incl. timestamps) must be set
+	MaxLen      uint32 `protobuf:"varint,2,opt,name=max_len,json=maxLen,proto3" json:"max_len,omitempty"`                // max. number of characters of a string
+	ObjectId    bool   `protobuf:"varint,3,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`          // a set string must be a hex encoded (mongodb) object id
+	KnownRole   bool   `protobuf:"varint,4,opt,name=known_role,json=knownRole,proto3" json:"known_role,omitempty"`       // a set string must be one of the roles configured by the service
+	After       string `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`                                 // a set timestamp must be after the (set) timestamp of the named sibling field
+	NonNegative bool   `protobuf:"varint,6,opt,name=non_negative,json=nonNegative,proto3" json:"non_negative,omitempty"` // numbers must not be negative
+	Skip        bool   `protobuf:"varint,7,opt,name=skip,proto3" json:"skip,omitempty"`                                  // fields of a nested message are not validated (e.g. partial updates validated by paths)
+}
+
+func (x *FieldRules) Reset() {
+	*x = FieldRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldRules) ProtoMessage() {}
+
+func (x *FieldRules) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldRules.ProtoReflect.Descriptor instead.
+func (*FieldRules) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FieldRules) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *FieldRules) GetMaxLen() uint32 {
+	if x != nil {
+		return x.MaxLen
+	}
+	return 0
+}
+
+func (x *FieldRules) GetObjectId() bool {
+	if x != nil {
+		return x.ObjectId
+	}
+	return false
+}
+
+func (x *FieldRules) GetKnownRole() bool {
+	if x != nil {
+		return x.KnownRole
+	}
+	return false
+}
+
+func (x *FieldRules) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *FieldRules) GetNonNegative() bool {
+	if x != nil {
+		return x.NonNegative
+	}
+	return false
+}
+
+func (x *FieldRules) GetSkip() bool {
+	if x != nil {
+		return x.Skip
+	}
+	return false
+}
+
 type Date struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -154,7 +367,7 @@ type Date struct {
 func (x *Date) Reset() {
 	*x = Date{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[1]
+		mi := &file_rf_example_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -167,7 +380,7 @@ func (x *Date) String() string {
 func (*Date) ProtoMessage() {}
 
 func (x *Date) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[1]
+	mi := &file_rf_example_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -180,7 +393,7 @@ func (x *Date) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Date.ProtoReflect.Descriptor instead.
 func (*Date) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{1}
+	return file_rf_example_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Date) GetYear() int32 {
@@ -219,12 +432,14 @@ type UserInviteCode struct {
 	MetaValidTo    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=meta_valid_to,json=metaValidTo,proto3" json:"meta_valid_to,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	DeletedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version        int64                  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"` // incremented on every write, used as etag for optimistic concurrency
 }
 
 func (x *UserInviteCode) Reset() {
 	*x = UserInviteCode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[2]
+		mi := &file_rf_example_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -237,7 +452,7 @@ func (x *UserInviteCode) String() string {
 func (*UserInviteCode) ProtoMessage() {}
 
 func (x *UserInviteCode) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[2]
+	mi := &file_rf_example_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,7 +465,7 @@ func (x *UserInviteCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInviteCode.ProtoReflect.Descriptor instead.
 func (*UserInviteCode) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{2}
+	return file_rf_example_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UserInviteCode) GetId() string {
@@ -323,6 +538,20 @@ func (x *UserInviteCode) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserInviteCode) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *UserInviteCode) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UserInviteCodeFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -330,12 +559,13 @@ type UserInviteCodeFilter struct {
 
 	MetaCode       string `protobuf:"bytes,1,opt,name=meta_code,json=metaCode,proto3" json:"meta_code,omitempty"`
 	MetaForAppRole string `protobuf:"bytes,2,opt,name=meta_for_app_role,json=metaForAppRole,proto3" json:"meta_for_app_role,omitempty"`
+	IncludeExpired bool   `protobuf:"varint,3,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"` // codes with meta_valid_to in the past are excluded otherwise
 }
 
 func (x *UserInviteCodeFilter) Reset() {
 	*x = UserInviteCodeFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[3]
+		mi := &file_rf_example_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -348,7 +578,7 @@ func (x *UserInviteCodeFilter) String() string {
 func (*UserInviteCodeFilter) ProtoMessage() {}
 
 func (x *UserInviteCodeFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[3]
+	mi := &file_rf_example_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,7 +591,7 @@ func (x *UserInviteCodeFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInviteCodeFilter.ProtoReflect.Descriptor instead.
 func (*UserInviteCodeFilter) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{3}
+	return file_rf_example_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UserInviteCodeFilter) GetMetaCode() string {
@@ -378,6 +608,13 @@ func (x *UserInviteCodeFilter) GetMetaForAppRole() string {
 	return ""
 }
 
+func (x *UserInviteCodeFilter) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
 type UserRole struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -399,7 +636,7 @@ type UserRole struct {
 func (x *UserRole) Reset() {
 	*x = UserRole{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[4]
+		mi := &file_rf_example_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -412,7 +649,7 @@ func (x *UserRole) String() string {
 func (*UserRole) ProtoMessage() {}
 
 func (x *UserRole) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[4]
+	mi := &file_rf_example_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -425,7 +662,7 @@ func (x *UserRole) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserRole.ProtoReflect.Descriptor instead.
 func (*UserRole) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{4}
+	return file_rf_example_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UserRole) GetId() string {
@@ -524,7 +761,7 @@ type UserGroup struct {
 func (x *UserGroup) Reset() {
 	*x = UserGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[5]
+		mi := &file_rf_example_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -537,7 +774,7 @@ func (x *UserGroup) String() string {
 func (*UserGroup) ProtoMessage() {}
 
 func (x *UserGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[5]
+	mi := &file_rf_example_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +787,7 @@ func (x *UserGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserGroup.ProtoReflect.Descriptor instead.
 func (*UserGroup) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{5}
+	return file_rf_example_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UserGroup) GetId() string {
@@ -640,7 +877,7 @@ type User struct {
 func (x *User) Reset() {
 	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[6]
+		mi := &file_rf_example_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -653,7 +890,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[6]
+	mi := &file_rf_example_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,7 +903,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{6}
+	return file_rf_example_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *User) GetId() string {
@@ -787,7 +1024,7 @@ type UserAddress struct {
 func (x *UserAddress) Reset() {
 	*x = UserAddress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[7]
+		mi := &file_rf_example_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -800,7 +1037,7 @@ func (x *UserAddress) String() string {
 func (*UserAddress) ProtoMessage() {}
 
 func (x *UserAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[7]
+	mi := &file_rf_example_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -813,7 +1050,7 @@ func (x *UserAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAddress.ProtoReflect.Descriptor instead.
 func (*UserAddress) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{7}
+	return file_rf_example_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UserAddress) GetId() string {
@@ -911,7 +1148,7 @@ type UserProfile struct {
 func (x *UserProfile) Reset() {
 	*x = UserProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[8]
+		mi := &file_rf_example_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -924,7 +1161,7 @@ func (x *UserProfile) String() string {
 func (*UserProfile) ProtoMessage() {}
 
 func (x *UserProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[8]
+	mi := &file_rf_example_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,7 +1174,7 @@ func (x *UserProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
 func (*UserProfile) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{8}
+	return file_rf_example_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UserProfile) GetId() string {
@@ -1057,7 +1294,7 @@ type UserConfig struct {
 func (x *UserConfig) Reset() {
 	*x = UserConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[9]
+		mi := &file_rf_example_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1070,7 +1307,7 @@ func (x *UserConfig) String() string {
 func (*UserConfig) ProtoMessage() {}
 
 func (x *UserConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[9]
+	mi := &file_rf_example_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1320,7 @@ func (x *UserConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserConfig.ProtoReflect.Descriptor instead.
 func (*UserConfig) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{9}
+	return file_rf_example_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UserConfig) GetId() string {
@@ -1111,7 +1348,7 @@ type CreateRoleReq struct {
 func (x *CreateRoleReq) Reset() {
 	*x = CreateRoleReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[10]
+		mi := &file_rf_example_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1124,7 +1361,7 @@ func (x *CreateRoleReq) String() string {
 func (*CreateRoleReq) ProtoMessage() {}
 
 func (x *CreateRoleReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[10]
+	mi := &file_rf_example_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,7 +1374,7 @@ func (x *CreateRoleReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoleReq.ProtoReflect.Descriptor instead.
 func (*CreateRoleReq) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{10}
+	return file_rf_example_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateRoleReq) GetRole() *UserRole {
@@ -1158,7 +1395,7 @@ type CreateRoleRes struct {
 func (x *CreateRoleRes) Reset() {
 	*x = CreateRoleRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[11]
+		mi := &file_rf_example_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1171,7 +1408,7 @@ func (x *CreateRoleRes) String() string {
 func (*CreateRoleRes) ProtoMessage() {}
 
 func (x *CreateRoleRes) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[11]
+	mi := &file_rf_example_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1184,7 +1421,7 @@ func (x *CreateRoleRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoleRes.ProtoReflect.Descriptor instead.
 func (*CreateRoleRes) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{11}
+	return file_rf_example_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CreateRoleRes) GetRole() *UserRole {
@@ -1205,7 +1442,7 @@ type GetRoleReq struct {
 func (x *GetRoleReq) Reset() {
 	*x = GetRoleReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[12]
+		mi := &file_rf_example_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1218,7 +1455,7 @@ func (x *GetRoleReq) String() string {
 func (*GetRoleReq) ProtoMessage() {}
 
 func (x *GetRoleReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[12]
+	mi := &file_rf_example_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,7 +1468,7 @@ func (x *GetRoleReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoleReq.ProtoReflect.Descriptor instead.
 func (*GetRoleReq) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{12}
+	return file_rf_example_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetRoleReq) GetId() string {
@@ -1252,7 +1489,7 @@ type GetRoleRes struct {
 func (x *GetRoleRes) Reset() {
 	*x = GetRoleRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[13]
+		mi := &file_rf_example_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1265,7 +1502,7 @@ func (x *GetRoleRes) String() string {
 func (*GetRoleRes) ProtoMessage() {}
 
 func (x *GetRoleRes) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[13]
+	mi := &file_rf_example_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1278,7 +1515,7 @@ func (x *GetRoleRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoleRes.ProtoReflect.Descriptor instead.
 func (*GetRoleRes) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{13}
+	return file_rf_example_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetRoleRes) GetRole() *UserRole {
@@ -1299,7 +1536,7 @@ type UpdateRoleReq struct {
 func (x *UpdateRoleReq) Reset() {
 	*x = UpdateRoleReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[14]
+		mi := &file_rf_example_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1312,7 +1549,7 @@ func (x *UpdateRoleReq) String() string {
 func (*UpdateRoleReq) ProtoMessage() {}
 
 func (x *UpdateRoleReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[14]
+	mi := &file_rf_example_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1325,7 +1562,7 @@ func (x *UpdateRoleReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRoleReq.ProtoReflect.Descriptor instead.
 func (*UpdateRoleReq) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{14}
+	return file_rf_example_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdateRoleReq) GetRole() *UserRole {
@@ -1346,7 +1583,7 @@ type UpdateRoleRes struct {
 func (x *UpdateRoleRes) Reset() {
 	*x = UpdateRoleRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[15]
+		mi := &file_rf_example_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1359,7 +1596,7 @@ func (x *UpdateRoleRes) String() string {
 func (*UpdateRoleRes) ProtoMessage() {}
 
 func (x *UpdateRoleRes) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[15]
+	mi := &file_rf_example_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1372,7 +1609,7 @@ func (x *UpdateRoleRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRoleRes.ProtoReflect.Descriptor instead.
 func (*UpdateRoleRes) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{15}
+	return file_rf_example_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateRoleRes) GetRole() *UserRole {
@@ -1393,7 +1630,7 @@ type DeleteRoleReq struct {
 func (x *DeleteRoleReq) Reset() {
 	*x = DeleteRoleReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[16]
+		mi := &file_rf_example_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1406,7 +1643,7 @@ func (x *DeleteRoleReq) String() string {
 func (*DeleteRoleReq) ProtoMessage() {}
 
 func (x *DeleteRoleReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[16]
+	mi := &file_rf_example_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1419,7 +1656,7 @@ func (x *DeleteRoleReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRoleReq.ProtoReflect.Descriptor instead.
 func (*DeleteRoleReq) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{16}
+	return file_rf_example_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeleteRoleReq) GetId() string {
@@ -1440,7 +1677,7 @@ type DeleteRoleRes struct {
 func (x *DeleteRoleRes) Reset() {
 	*x = DeleteRoleRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[17]
+		mi := &file_rf_example_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1453,7 +1690,7 @@ func (x *DeleteRoleRes) String() string {
 func (*DeleteRoleRes) ProtoMessage() {}
 
 func (x *DeleteRoleRes) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[17]
+	mi := &file_rf_example_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,7 +1703,7 @@ func (x *DeleteRoleRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRoleRes.ProtoReflect.Descriptor instead.
 func (*DeleteRoleRes) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{17}
+	return file_rf_example_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeleteRoleRes) GetSuccess() bool {
@@ -1485,7 +1722,7 @@ type ListRoleReq struct {
 func (x *ListRoleReq) Reset() {
 	*x = ListRoleReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[18]
+		mi := &file_rf_example_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1498,7 +1735,7 @@ func (x *ListRoleReq) String() string {
 func (*ListRoleReq) ProtoMessage() {}
 
 func (x *ListRoleReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[18]
+	mi := &file_rf_example_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1511,7 +1748,7 @@ func (x *ListRoleReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoleReq.ProtoReflect.Descriptor instead.
 func (*ListRoleReq) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{18}
+	return file_rf_example_proto_rawDescGZIP(), []int{19}
 }
 
 type ListRoleRes struct {
@@ -1525,7 +1762,7 @@ type ListRoleRes struct {
 func (x *ListRoleRes) Reset() {
 	*x = ListRoleRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[19]
+		mi := &file_rf_example_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1538,7 +1775,7 @@ func (x *ListRoleRes) String() string {
 func (*ListRoleRes) ProtoMessage() {}
 
 func (x *ListRoleRes) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[19]
+	mi := &file_rf_example_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,7 +1788,7 @@ func (x *ListRoleRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoleRes.ProtoReflect.Descriptor instead.
 func (*ListRoleRes) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{19}
+	return file_rf_example_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListRoleRes) GetRole() *UserRole {
@@ -1561,7 +1798,7 @@ func (x *ListRoleRes) GetRole() *UserRole {
 	return nil
 }
 
-type CreateInviteCodeReq struct {
+type CreateInviteCodeRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1569,63 +1806,16 @@ type CreateInviteCodeReq struct {
 	InviteCode *UserInviteCode `protobuf:"bytes,1,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
 }
 
-func (x *CreateInviteCodeReq) Reset() {
-	*x = CreateInviteCodeReq{}
+func (x *CreateInviteCodeRes) Reset() {
+	*x = CreateInviteCodeRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[20]
+		mi := &file_rf_example_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateInviteCodeReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateInviteCodeReq) ProtoMessage() {}
-
-func (x *CreateInviteCodeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateInviteCodeReq.ProtoReflect.Descriptor instead.
-func (*CreateInviteCodeReq) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *CreateInviteCodeReq) GetInviteCode() *UserInviteCode {
-	if x != nil {
-		return x.InviteCode
-	}
-	return nil
-}
-
-type CreateInviteCodeRes struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	InviteCode *UserInviteCode `protobuf:"bytes,1,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
-}
-
-func (x *CreateInviteCodeRes) Reset() {
-	*x = CreateInviteCodeRes{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CreateInviteCodeRes) String() string {
+func (x *CreateInviteCodeRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
@@ -1655,16 +1845,16 @@ func (x *CreateInviteCodeRes) GetInviteCode() *UserInviteCode {
 	return nil
 }
 
-type GetInviteCodeReq struct {
+type GetInviteCodeRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InviteCode *UserInviteCode `protobuf:"bytes,1,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
 }
 
-func (x *GetInviteCodeReq) Reset() {
-	*x = GetInviteCodeReq{}
+func (x *GetInviteCodeRes) Reset() {
+	*x = GetInviteCodeRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1672,13 +1862,13 @@ func (x *GetInviteCodeReq) Reset() {
 	}
 }
 
-func (x *GetInviteCodeReq) String() string {
+func (x *GetInviteCodeRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInviteCodeReq) ProtoMessage() {}
+func (*GetInviteCodeRes) ProtoMessage() {}
 
-func (x *GetInviteCodeReq) ProtoReflect() protoreflect.Message {
+func (x *GetInviteCodeRes) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1690,19 +1880,19 @@ func (x *GetInviteCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInviteCodeReq.ProtoReflect.Descriptor instead.
-func (*GetInviteCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInviteCodeRes.ProtoReflect.Descriptor instead.
+func (*GetInviteCodeRes) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetInviteCodeReq) GetId() string {
+func (x *GetInviteCodeRes) GetInviteCode() *UserInviteCode {
 	if x != nil {
-		return x.Id
+		return x.InviteCode
 	}
-	return ""
+	return nil
 }
 
-type GetInviteCodeRes struct {
+type UpdateInviteCodeRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1710,8 +1900,8 @@ type GetInviteCodeRes struct {
 	InviteCode *UserInviteCode `protobuf:"bytes,1,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
 }
 
-func (x *GetInviteCodeRes) Reset() {
-	*x = GetInviteCodeRes{}
+func (x *UpdateInviteCodeRes) Reset() {
+	*x = UpdateInviteCodeRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1719,13 +1909,13 @@ func (x *GetInviteCodeRes) Reset() {
 	}
 }
 
-func (x *GetInviteCodeRes) String() string {
+func (x *UpdateInviteCodeRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInviteCodeRes) ProtoMessage() {}
+func (*UpdateInviteCodeRes) ProtoMessage() {}
 
-func (x *GetInviteCodeRes) ProtoReflect() protoreflect.Message {
+func (x *UpdateInviteCodeRes) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1737,28 +1927,28 @@ func (x *GetInviteCodeRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInviteCodeRes.ProtoReflect.Descriptor instead.
-func (*GetInviteCodeRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateInviteCodeRes.ProtoReflect.Descriptor instead.
+func (*UpdateInviteCodeRes) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetInviteCodeRes) GetInviteCode() *UserInviteCode {
+func (x *UpdateInviteCodeRes) GetInviteCode() *UserInviteCode {
 	if x != nil {
 		return x.InviteCode
 	}
 	return nil
 }
 
-type UpdateInviteCodeReq struct {
+type DeleteInviteCodeRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InviteCode *UserInviteCode `protobuf:"bytes,1,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *UpdateInviteCodeReq) Reset() {
-	*x = UpdateInviteCodeReq{}
+func (x *DeleteInviteCodeRes) Reset() {
+	*x = DeleteInviteCodeRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1766,13 +1956,13 @@ func (x *UpdateInviteCodeReq) Reset() {
 	}
 }
 
-func (x *UpdateInviteCodeReq) String() string {
+func (x *DeleteInviteCodeRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInviteCodeReq) ProtoMessage() {}
+func (*DeleteInviteCodeRes) ProtoMessage() {}
 
-func (x *UpdateInviteCodeReq) ProtoReflect() protoreflect.Message {
+func (x *DeleteInviteCodeRes) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1784,28 +1974,28 @@ func (x *UpdateInviteCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInviteCodeReq.ProtoReflect.Descriptor instead.
-func (*UpdateInviteCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteInviteCodeRes.ProtoReflect.Descriptor instead.
+func (*DeleteInviteCodeRes) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpdateInviteCodeReq) GetInviteCode() *UserInviteCode {
+func (x *DeleteInviteCodeRes) GetSuccess() bool {
 	if x != nil {
-		return x.InviteCode
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type UpdateInviteCodeRes struct {
+type ListFilteredInviteCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InviteCode *UserInviteCode `protobuf:"bytes,1,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
+	Filter *UserInviteCodeFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *UpdateInviteCodeRes) Reset() {
-	*x = UpdateInviteCodeRes{}
+func (x *ListFilteredInviteCodeReq) Reset() {
+	*x = ListFilteredInviteCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1813,13 +2003,13 @@ func (x *UpdateInviteCodeRes) Reset() {
 	}
 }
 
-func (x *UpdateInviteCodeRes) String() string {
+func (x *ListFilteredInviteCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInviteCodeRes) ProtoMessage() {}
+func (*ListFilteredInviteCodeReq) ProtoMessage() {}
 
-func (x *UpdateInviteCodeRes) ProtoReflect() protoreflect.Message {
+func (x *ListFilteredInviteCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1831,28 +2021,28 @@ func (x *UpdateInviteCodeRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInviteCodeRes.ProtoReflect.Descriptor instead.
-func (*UpdateInviteCodeRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListFilteredInviteCodeReq.ProtoReflect.Descriptor instead.
+func (*ListFilteredInviteCodeReq) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UpdateInviteCodeRes) GetInviteCode() *UserInviteCode {
+func (x *ListFilteredInviteCodeReq) GetFilter() *UserInviteCodeFilter {
 	if x != nil {
-		return x.InviteCode
+		return x.Filter
 	}
 	return nil
 }
 
-type DeleteInviteCodeReq struct {
+type ListFilteredInviteCodeRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InviteCode *UserInviteCode `protobuf:"bytes,1,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
 }
 
-func (x *DeleteInviteCodeReq) Reset() {
-	*x = DeleteInviteCodeReq{}
+func (x *ListFilteredInviteCodeRes) Reset() {
+	*x = ListFilteredInviteCodeRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1860,13 +2050,13 @@ func (x *DeleteInviteCodeReq) Reset() {
 	}
 }
 
-func (x *DeleteInviteCodeReq) String() string {
+func (x *ListFilteredInviteCodeRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteInviteCodeReq) ProtoMessage() {}
+func (*ListFilteredInviteCodeRes) ProtoMessage() {}
 
-func (x *DeleteInviteCodeReq) ProtoReflect() protoreflect.Message {
+func (x *ListFilteredInviteCodeRes) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1878,28 +2068,28 @@ func (x *DeleteInviteCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteInviteCodeReq.ProtoReflect.Descriptor instead.
-func (*DeleteInviteCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListFilteredInviteCodeRes.ProtoReflect.Descriptor instead.
+func (*ListFilteredInviteCodeRes) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DeleteInviteCodeReq) GetId() string {
+func (x *ListFilteredInviteCodeRes) GetInviteCode() *UserInviteCode {
 	if x != nil {
-		return x.Id
+		return x.InviteCode
 	}
-	return ""
+	return nil
 }
 
-type DeleteInviteCodeRes struct {
+type ListInviteCodeRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	InviteCode *UserInviteCode `protobuf:"bytes,1,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
 }
 
-func (x *DeleteInviteCodeRes) Reset() {
-	*x = DeleteInviteCodeRes{}
+func (x *ListInviteCodeRes) Reset() {
+	*x = ListInviteCodeRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1907,13 +2097,13 @@ func (x *DeleteInviteCodeRes) Reset() {
 	}
 }
 
-func (x *DeleteInviteCodeRes) String() string {
+func (x *ListInviteCodeRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteInviteCodeRes) ProtoMessage() {}
+func (*ListInviteCodeRes) ProtoMessage() {}
 
-func (x *DeleteInviteCodeRes) ProtoReflect() protoreflect.Message {
+func (x *ListInviteCodeRes) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1925,28 +2115,26 @@ func (x *DeleteInviteCodeRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteInviteCodeRes.ProtoReflect.Descriptor instead.
-func (*DeleteInviteCodeRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListInviteCodeRes.ProtoReflect.Descriptor instead.
+func (*ListInviteCodeRes) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DeleteInviteCodeRes) GetSuccess() bool {
+func (x *ListInviteCodeRes) GetInviteCode() *UserInviteCode {
 	if x != nil {
-		return x.Success
+		return x.InviteCode
 	}
-	return false
+	return nil
 }
 
-type ListFilteredInviteCodeReq struct {
+type VersionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Filter *UserInviteCodeFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *ListFilteredInviteCodeReq) Reset() {
-	*x = ListFilteredInviteCodeReq{}
+func (x *VersionReq) Reset() {
+	*x = VersionReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1954,13 +2142,13 @@ func (x *ListFilteredInviteCodeReq) Reset() {
 	}
 }
 
-func (x *ListFilteredInviteCodeReq) String() string {
+func (x *VersionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFilteredInviteCodeReq) ProtoMessage() {}
+func (*VersionReq) ProtoMessage() {}
 
-func (x *ListFilteredInviteCodeReq) ProtoReflect() protoreflect.Message {
+func (x *VersionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1972,28 +2160,21 @@ func (x *ListFilteredInviteCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFilteredInviteCodeReq.ProtoReflect.Descriptor instead.
-func (*ListFilteredInviteCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use VersionReq.ProtoReflect.Descriptor instead.
+func (*VersionReq) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ListFilteredInviteCodeReq) GetFilter() *UserInviteCodeFilter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
-}
-
-type ListFilteredInviteCodeRes struct {
+type VersionRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InviteCode *UserInviteCode `protobuf:"bytes,1,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *ListFilteredInviteCodeRes) Reset() {
-	*x = ListFilteredInviteCodeRes{}
+func (x *VersionRes) Reset() {
+	*x = VersionRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2001,13 +2182,13 @@ func (x *ListFilteredInviteCodeRes) Reset() {
 	}
 }
 
-func (x *ListFilteredInviteCodeRes) String() string {
+func (x *VersionRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFilteredInviteCodeRes) ProtoMessage() {}
+func (*VersionRes) ProtoMessage() {}
 
-func (x *ListFilteredInviteCodeRes) ProtoReflect() protoreflect.Message {
+func (x *VersionRes) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2019,26 +2200,28 @@ func (x *ListFilteredInviteCodeRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFilteredInviteCodeRes.ProtoReflect.Descriptor instead.
-func (*ListFilteredInviteCodeRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use VersionRes.ProtoReflect.Descriptor instead.
+func (*VersionRes) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListFilteredInviteCodeRes) GetInviteCode() *UserInviteCode {
+func (x *VersionRes) GetVersion() string {
 	if x != nil {
-		return x.InviteCode
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-type ListInviteCodeReq struct {
+type CreateInviteCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	InviteCode *UserInviteCode `protobuf:"bytes,1,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
 }
 
-func (x *ListInviteCodeReq) Reset() {
-	*x = ListInviteCodeReq{}
+func (x *CreateInviteCodeReq) Reset() {
+	*x = CreateInviteCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2046,13 +2229,13 @@ func (x *ListInviteCodeReq) Reset() {
 	}
 }
 
-func (x *ListInviteCodeReq) String() string {
+func (x *CreateInviteCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInviteCodeReq) ProtoMessage() {}
+func (*CreateInviteCodeReq) ProtoMessage() {}
 
-func (x *ListInviteCodeReq) ProtoReflect() protoreflect.Message {
+func (x *CreateInviteCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2064,21 +2247,30 @@ func (x *ListInviteCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInviteCodeReq.ProtoReflect.Descriptor instead.
-func (*ListInviteCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateInviteCodeReq.ProtoReflect.Descriptor instead.
+func (*CreateInviteCodeReq) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{30}
 }
 
-type ListInviteCodeRes struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	InviteCode *UserInviteCode `protobuf:"bytes,1,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
+func (x *CreateInviteCodeReq) GetInviteCode() *UserInviteCode {
+	if x != nil {
+		return x.InviteCode
+	}
+	return nil
 }
 
-func (x *ListInviteCodeRes) Reset() {
-	*x = ListInviteCodeRes{}
+// GetInviteCodeReq fails with FAILED_PRECONDITION (CODE_EXPIRED) for expired codes unless include_expired is set.
+type GetInviteCodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeExpired bool   `protobuf:"varint,2,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+}
+
+func (x *GetInviteCodeReq) Reset() {
+	*x = GetInviteCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2086,13 +2278,13 @@ func (x *ListInviteCodeRes) Reset() {
 	}
 }
 
-func (x *ListInviteCodeRes) String() string {
+func (x *GetInviteCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInviteCodeRes) ProtoMessage() {}
+func (*GetInviteCodeReq) ProtoMessage() {}
 
-func (x *ListInviteCodeRes) ProtoReflect() protoreflect.Message {
+func (x *GetInviteCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2104,26 +2296,35 @@ func (x *ListInviteCodeRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInviteCodeRes.ProtoReflect.Descriptor instead.
-func (*ListInviteCodeRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInviteCodeReq.ProtoReflect.Descriptor instead.
+func (*GetInviteCodeReq) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListInviteCodeRes) GetInviteCode() *UserInviteCode {
+func (x *GetInviteCodeReq) GetId() string {
 	if x != nil {
-		return x.InviteCode
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type VersionReq struct {
+func (x *GetInviteCodeReq) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type ListInviteCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	IncludeExpired bool `protobuf:"varint,1,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"` // codes with meta_valid_to in the past are excluded otherwise
 }
 
-func (x *VersionReq) Reset() {
-	*x = VersionReq{}
+func (x *ListInviteCodeReq) Reset() {
+	*x = ListInviteCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2131,13 +2332,13 @@ func (x *VersionReq) Reset() {
 	}
 }
 
-func (x *VersionReq) String() string {
+func (x *ListInviteCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionReq) ProtoMessage() {}
+func (*ListInviteCodeReq) ProtoMessage() {}
 
-func (x *VersionReq) ProtoReflect() protoreflect.Message {
+func (x *ListInviteCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2149,21 +2350,34 @@ func (x *VersionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionReq.ProtoReflect.Descriptor instead.
-func (*VersionReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListInviteCodeReq.ProtoReflect.Descriptor instead.
+func (*ListInviteCodeReq) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{32}
 }
 
-type VersionRes struct {
+func (x *ListInviteCodeReq) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+// UpdateInviteCodeReq updates the paths listed in update_mask only (e.g. "meta_valid_to"), without a mask all
+// populated mutable fields of inviteCode are updated. The code to update is identified by inviteCode.id.
+// expected_version rejects the update with ABORTED if the stored version differs (0 = unconditional, the "if-match"
+// header is used instead if present).
+type UpdateInviteCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	InviteCode      *UserInviteCode        `protobuf:"bytes,1,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"`
+	UpdateMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
-func (x *VersionRes) Reset() {
-	*x = VersionRes{}
+func (x *UpdateInviteCodeReq) Reset() {
+	*x = UpdateInviteCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2171,13 +2385,13 @@ func (x *VersionRes) Reset() {
 	}
 }
 
-func (x *VersionRes) String() string {
+func (x *UpdateInviteCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionRes) ProtoMessage() {}
+func (*UpdateInviteCodeReq) ProtoMessage() {}
 
-func (x *VersionRes) ProtoReflect() protoreflect.Message {
+func (x *UpdateInviteCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2189,29 +2403,45 @@ func (x *VersionRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionRes.ProtoReflect.Descriptor instead.
-func (*VersionRes) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateInviteCodeReq.ProtoReflect.Descriptor instead.
+func (*UpdateInviteCodeReq) Descriptor() ([]byte, []int) {
 	return file_rf_example_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *VersionRes) GetVersion() string {
+func (x *UpdateInviteCodeReq) GetInviteCode() *UserInviteCode {
 	if x != nil {
-		return x.Version
+		return x.InviteCode
 	}
-	return ""
+	return nil
 }
 
-type UserProfile_PhoneNumber struct {
+func (x *UpdateInviteCodeReq) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateInviteCodeReq) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// WatchInviteCodesReq streams the changes of invite codes (of the given role only), a client reconnects without
+// missing events by sending the resume_token of the last received event. The stream ends with OK on shutdown.
+type WatchInviteCodesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Number string                `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
-	Type   UserProfile_PhoneType `protobuf:"varint,2,opt,name=type,proto3,enum=aribor.UserProfile_PhoneType" json:"type,omitempty"`
+	MetaForAppRole string `protobuf:"bytes,1,opt,name=meta_for_app_role,json=metaForAppRole,proto3" json:"meta_for_app_role,omitempty"`
+	ResumeToken    string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
 }
 
-func (x *UserProfile_PhoneNumber) Reset() {
-	*x = UserProfile_PhoneNumber{}
+func (x *WatchInviteCodesReq) Reset() {
+	*x = WatchInviteCodesReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2219,13 +2449,13 @@ func (x *UserProfile_PhoneNumber) Reset() {
 	}
 }
 
-func (x *UserProfile_PhoneNumber) String() string {
+func (x *WatchInviteCodesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserProfile_PhoneNumber) ProtoMessage() {}
+func (*WatchInviteCodesReq) ProtoMessage() {}
 
-func (x *UserProfile_PhoneNumber) ProtoReflect() protoreflect.Message {
+func (x *WatchInviteCodesReq) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2237,40 +2467,38 @@ func (x *UserProfile_PhoneNumber) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserProfile_PhoneNumber.ProtoReflect.Descriptor instead.
-func (*UserProfile_PhoneNumber) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use WatchInviteCodesReq.ProtoReflect.Descriptor instead.
+func (*WatchInviteCodesReq) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UserProfile_PhoneNumber) GetNumber() string {
+func (x *WatchInviteCodesReq) GetMetaForAppRole() string {
 	if x != nil {
-		return x.Number
+		return x.MetaForAppRole
 	}
 	return ""
 }
 
-func (x *UserProfile_PhoneNumber) GetType() UserProfile_PhoneType {
+func (x *WatchInviteCodesReq) GetResumeToken() string {
 	if x != nil {
-		return x.Type
+		return x.ResumeToken
 	}
-	return UserProfile_UNKNOWN
+	return ""
 }
 
-type UserConfig_Layout struct {
+type WatchInviteCodesRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Style            string `protobuf:"bytes,1,opt,name=style,proto3" json:"style,omitempty"`
-	CustomScrollbars bool   `protobuf:"varint,2,opt,name=custom_scrollbars,json=customScrollbars,proto3" json:"custom_scrollbars,omitempty"`
-	ThemeMain        string `protobuf:"bytes,3,opt,name=theme_main,json=themeMain,proto3" json:"theme_main,omitempty"`
-	ThemeNavbar      string `protobuf:"bytes,4,opt,name=theme_navbar,json=themeNavbar,proto3" json:"theme_navbar,omitempty"`
-	ThemeToolbar     string `protobuf:"bytes,5,opt,name=theme_toolbar,json=themeToolbar,proto3" json:"theme_toolbar,omitempty"`
-	ThemeFooter      string `protobuf:"bytes,6,opt,name=theme_footer,json=themeFooter,proto3" json:"theme_footer,omitempty"`
+	Type        WatchInviteCodesRes_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=aribor.WatchInviteCodesRes_EventType" json:"type,omitempty"`
+	InviteCode  *UserInviteCode               `protobuf:"bytes,2,opt,name=inviteCode,proto3" json:"inviteCode,omitempty"` // id only for codes removed from the database (e.g. TTL index)
+	ResumeToken string                        `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	OccurredAt  *timestamppb.Timestamp        `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
 }
 
-func (x *UserConfig_Layout) Reset() {
-	*x = UserConfig_Layout{}
+func (x *WatchInviteCodesRes) Reset() {
+	*x = WatchInviteCodesRes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rf_example_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2278,13 +2506,13 @@ func (x *UserConfig_Layout) Reset() {
 	}
 }
 
-func (x *UserConfig_Layout) String() string {
+func (x *WatchInviteCodesRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserConfig_Layout) ProtoMessage() {}
+func (*WatchInviteCodesRes) ProtoMessage() {}
 
-func (x *UserConfig_Layout) ProtoReflect() protoreflect.Message {
+func (x *WatchInviteCodesRes) ProtoReflect() protoreflect.Message {
 	mi := &file_rf_example_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2296,79 +2524,122 @@ func (x *UserConfig_Layout) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserConfig_Layout.ProtoReflect.Descriptor instead.
-func (*UserConfig_Layout) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use WatchInviteCodesRes.ProtoReflect.Descriptor instead.
+func (*WatchInviteCodesRes) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *UserConfig_Layout) GetStyle() string {
+func (x *WatchInviteCodesRes) GetType() WatchInviteCodesRes_EventType {
 	if x != nil {
-		return x.Style
+		return x.Type
 	}
-	return ""
+	return WatchInviteCodesRes_EVENT_TYPE_UNSPECIFIED
 }
 
-func (x *UserConfig_Layout) GetCustomScrollbars() bool {
+func (x *WatchInviteCodesRes) GetInviteCode() *UserInviteCode {
 	if x != nil {
-		return x.CustomScrollbars
+		return x.InviteCode
 	}
-	return false
+	return nil
 }
 
-func (x *UserConfig_Layout) GetThemeMain() string {
+func (x *WatchInviteCodesRes) GetResumeToken() string {
 	if x != nil {
-		return x.ThemeMain
+		return x.ResumeToken
 	}
 	return ""
 }
 
-func (x *UserConfig_Layout) GetThemeNavbar() string {
+func (x *WatchInviteCodesRes) GetOccurredAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ThemeNavbar
+		return x.OccurredAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UserConfig_Layout) GetThemeToolbar() string {
+// DeleteInviteCodeReq deletes the code if its version equals expected_version (see UpdateInviteCodeReq).
+type DeleteInviteCodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpectedVersion int64  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *DeleteInviteCodeReq) Reset() {
+	*x = DeleteInviteCodeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteInviteCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInviteCodeReq) ProtoMessage() {}
+
+func (x *DeleteInviteCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInviteCodeReq.ProtoReflect.Descriptor instead.
+func (*DeleteInviteCodeReq) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteInviteCodeReq) GetId() string {
 	if x != nil {
-		return x.ThemeToolbar
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UserConfig_Layout) GetThemeFooter() string {
+func (x *DeleteInviteCodeReq) GetExpectedVersion() int64 {
 	if x != nil {
-		return x.ThemeFooter
+		return x.ExpectedVersion
 	}
-	return ""
+	return 0
 }
 
-type UserConfig_Layout_LayoutConfig struct {
+type FaultInjectionState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Scroll string `protobuf:"bytes,1,opt,name=scroll,proto3" json:"scroll,omitempty"`
-	Mode   string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Enabled   bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RulesFile string   `protobuf:"bytes,2,opt,name=rules_file,json=rulesFile,proto3" json:"rules_file,omitempty"`
+	Rules     []string `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
-func (x *UserConfig_Layout_LayoutConfig) Reset() {
-	*x = UserConfig_Layout_LayoutConfig{}
+func (x *FaultInjectionState) Reset() {
+	*x = FaultInjectionState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[36]
+		mi := &file_rf_example_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserConfig_Layout_LayoutConfig) String() string {
+func (x *FaultInjectionState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserConfig_Layout_LayoutConfig) ProtoMessage() {}
+func (*FaultInjectionState) ProtoMessage() {}
 
-func (x *UserConfig_Layout_LayoutConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[36]
+func (x *FaultInjectionState) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2379,52 +2650,61 @@ func (x *UserConfig_Layout_LayoutConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserConfig_Layout_LayoutConfig.ProtoReflect.Descriptor instead.
-func (*UserConfig_Layout_LayoutConfig) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{9, 0, 0}
+// Deprecated: Use FaultInjectionState.ProtoReflect.Descriptor instead.
+func (*FaultInjectionState) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UserConfig_Layout_LayoutConfig) GetScroll() string {
+func (x *FaultInjectionState) GetEnabled() bool {
 	if x != nil {
-		return x.Scroll
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *UserConfig_Layout_LayoutConfig) GetMode() string {
+func (x *FaultInjectionState) GetRulesFile() string {
 	if x != nil {
-		return x.Mode
+		return x.RulesFile
 	}
 	return ""
 }
 
-type UserConfig_Layout_LayoutConfig_LayoutBlockConfig struct {
+func (x *FaultInjectionState) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SeedRoleReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	Display  bool   `protobuf:"varint,2,opt,name=display,proto3" json:"display,omitempty"`
-	Folded   bool   `protobuf:"varint,3,opt,name=folded,proto3" json:"folded,omitempty"`
+	Role      string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Requested int32    `protobuf:"varint,2,opt,name=requested,proto3" json:"requested,omitempty"`
+	Created   int32    `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Failed    int32    `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors    []string `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) Reset() {
-	*x = UserConfig_Layout_LayoutConfig_LayoutBlockConfig{}
+func (x *SeedRoleReport) Reset() {
+	*x = SeedRoleReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rf_example_proto_msgTypes[37]
+		mi := &file_rf_example_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) String() string {
+func (x *SeedRoleReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserConfig_Layout_LayoutConfig_LayoutBlockConfig) ProtoMessage() {}
+func (*SeedRoleReport) ProtoMessage() {}
 
-func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_rf_example_proto_msgTypes[37]
+func (x *SeedRoleReport) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2435,331 +2715,1806 @@ func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) ProtoReflect() protor
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserConfig_Layout_LayoutConfig_LayoutBlockConfig.ProtoReflect.Descriptor instead.
-func (*UserConfig_Layout_LayoutConfig_LayoutBlockConfig) Descriptor() ([]byte, []int) {
-	return file_rf_example_proto_rawDescGZIP(), []int{9, 0, 0, 0}
+// Deprecated: Use SeedRoleReport.ProtoReflect.Descriptor instead.
+func (*SeedRoleReport) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) GetPosition() string {
+func (x *SeedRoleReport) GetRole() string {
 	if x != nil {
-		return x.Position
+		return x.Role
 	}
 	return ""
 }
 
-func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) GetDisplay() bool {
+func (x *SeedRoleReport) GetRequested() int32 {
 	if x != nil {
-		return x.Display
+		return x.Requested
 	}
-	return false
+	return 0
 }
 
-func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) GetFolded() bool {
+func (x *SeedRoleReport) GetCreated() int32 {
 	if x != nil {
-		return x.Folded
+		return x.Created
 	}
-	return false
+	return 0
 }
 
-var File_rf_example_proto protoreflect.FileDescriptor
+func (x *SeedRoleReport) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
 
-var file_rf_example_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x72, 0x66, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x06, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x07, 0x0a, 0x05, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x42, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72,
-	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0xb9, 0x03, 0x0a, 0x0e, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
-	0x65, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x61,
-	0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x52,
-	0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x69, 0x78, 0x74, 0x75,
-	0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x54, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x6d, 0x65,
-	0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0d, 0x6d, 0x65, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3e,
-	0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x6f, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0x5e, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
-	0x6d, 0x65, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x65, 0x74,
-	0x61, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70,
-	0x52, 0x6f, 0x6c, 0x65, 0x22, 0x86, 0x03, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe4, 0x02,
-	0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x78, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x69, 0x78,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0xa6, 0x04, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x20, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x5f, 0x70, 0x77, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x50, 0x77, 0x64, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x5f, 0x70, 0x77, 0x64, 0x5f, 0x73, 0x61, 0x6c,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x50, 0x77, 0x64, 0x53,
-	0x61, 0x6c, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x65, 0x63, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x55,
-	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72, 0x69,
-	0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x08, 0x75, 0x73,
-	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
-	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01,
+func (x *SeedRoleReport) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type SeedFixturesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success    bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message    string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Partial    bool                   `protobuf:"varint,5,opt,name=partial,proto3" json:"partial,omitempty"`
+	Roles      []*SeedRoleReport      `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
+	Profile    string                 `protobuf:"bytes,7,opt,name=profile,proto3" json:"profile,omitempty"`
+	Seed       int64                  `protobuf:"varint,8,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (x *SeedFixturesRes) Reset() {
+	*x = SeedFixturesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeedFixturesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeedFixturesRes) ProtoMessage() {}
+
+func (x *SeedFixturesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeedFixturesRes.ProtoReflect.Descriptor instead.
+func (*SeedFixturesRes) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SeedFixturesRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SeedFixturesRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SeedFixturesRes) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *SeedFixturesRes) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *SeedFixturesRes) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+func (x *SeedFixturesRes) GetRoles() []*SeedRoleReport {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *SeedFixturesRes) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *SeedFixturesRes) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type RuntimeStateRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version          string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	HealthStatus     string                 `protobuf:"bytes,2,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
+	LogLevel         string                 `protobuf:"bytes,3,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	Draining         bool                   `protobuf:"varint,4,opt,name=draining,proto3" json:"draining,omitempty"`
+	InflightRequests int64                  `protobuf:"varint,5,opt,name=inflight_requests,json=inflightRequests,proto3" json:"inflight_requests,omitempty"`
+	Faults           *FaultInjectionState   `protobuf:"bytes,6,opt,name=faults,proto3" json:"faults,omitempty"`
+	LastSeed         *SeedFixturesRes       `protobuf:"bytes,7,opt,name=last_seed,json=lastSeed,proto3" json:"last_seed,omitempty"`
+	StartedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	MongodbConnected bool                   `protobuf:"varint,9,opt,name=mongodb_connected,json=mongodbConnected,proto3" json:"mongodb_connected,omitempty"`
+	MongodbChangedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=mongodb_changed_at,json=mongodbChangedAt,proto3" json:"mongodb_changed_at,omitempty"`
+}
+
+func (x *RuntimeStateRes) Reset() {
+	*x = RuntimeStateRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeStateRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeStateRes) ProtoMessage() {}
+
+func (x *RuntimeStateRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeStateRes.ProtoReflect.Descriptor instead.
+func (*RuntimeStateRes) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RuntimeStateRes) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RuntimeStateRes) GetHealthStatus() string {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return ""
+}
+
+func (x *RuntimeStateRes) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+func (x *RuntimeStateRes) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *RuntimeStateRes) GetInflightRequests() int64 {
+	if x != nil {
+		return x.InflightRequests
+	}
+	return 0
+}
+
+func (x *RuntimeStateRes) GetFaults() *FaultInjectionState {
+	if x != nil {
+		return x.Faults
+	}
+	return nil
+}
+
+func (x *RuntimeStateRes) GetLastSeed() *SeedFixturesRes {
+	if x != nil {
+		return x.LastSeed
+	}
+	return nil
+}
+
+func (x *RuntimeStateRes) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *RuntimeStateRes) GetMongodbConnected() bool {
+	if x != nil {
+		return x.MongodbConnected
+	}
+	return false
+}
+
+func (x *RuntimeStateRes) GetMongodbChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MongodbChangedAt
+	}
+	return nil
+}
+
+// WebhookDelivery is a single event queued for (or delivered to) a webhook endpoint
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Endpoint       string                 `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // pending, delivered or failed (max. attempts reached)
+	Attempts       int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastStatusCode int32                  `protobuf:"varint,7,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+// ListWebhookDeliveriesReq lists the latest deliveries (all filters optional, limit defaults to 100)
+type ListWebhookDeliveriesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoint  string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	EventType string `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Status    string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Limit     int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesReq) Reset() {
+	*x = ListWebhookDeliveriesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesReq) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesReq.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesReq) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListWebhookDeliveriesReq) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesReq) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesRes) Reset() {
+	*x = ListWebhookDeliveriesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRes) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRes.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRes) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListWebhookDeliveriesRes) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type SeedFixturesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *SeedFixturesReq) Reset() {
+	*x = SeedFixturesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeedFixturesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeedFixturesReq) ProtoMessage() {}
+
+func (x *SeedFixturesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeedFixturesReq.ProtoReflect.Descriptor instead.
+func (*SeedFixturesReq) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SeedFixturesReq) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+type ToggleLatencyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ToggleLatencyReq) Reset() {
+	*x = ToggleLatencyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToggleLatencyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleLatencyReq) ProtoMessage() {}
+
+func (x *ToggleLatencyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleLatencyReq.ProtoReflect.Descriptor instead.
+func (*ToggleLatencyReq) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{45}
+}
+
+type ToggleLatencyRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Faults  *FaultInjectionState `protobuf:"bytes,1,opt,name=faults,proto3" json:"faults,omitempty"`
+	Message string               `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ToggleLatencyRes) Reset() {
+	*x = ToggleLatencyRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToggleLatencyRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleLatencyRes) ProtoMessage() {}
+
+func (x *ToggleLatencyRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleLatencyRes.ProtoReflect.Descriptor instead.
+func (*ToggleLatencyRes) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ToggleLatencyRes) GetFaults() *FaultInjectionState {
+	if x != nil {
+		return x.Faults
+	}
+	return nil
+}
+
+func (x *ToggleLatencyRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ReloadConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReloadConfigReq) Reset() {
+	*x = ReloadConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigReq) ProtoMessage() {}
+
+func (x *ReloadConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigReq.ProtoReflect.Descriptor instead.
+func (*ReloadConfigReq) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{47}
+}
+
+type ReloadConfigRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ReloadConfigRes) Reset() {
+	*x = ReloadConfigRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigRes) ProtoMessage() {}
+
+func (x *ReloadConfigRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigRes.ProtoReflect.Descriptor instead.
+func (*ReloadConfigRes) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReloadConfigRes) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReloadConfigRes) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DrainReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timeout *durationpb.Duration `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *DrainReq) Reset() {
+	*x = DrainReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainReq) ProtoMessage() {}
+
+func (x *DrainReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainReq.ProtoReflect.Descriptor instead.
+func (*DrainReq) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DrainReq) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type DrainRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Draining         bool  `protobuf:"varint,1,opt,name=draining,proto3" json:"draining,omitempty"`
+	InflightRequests int64 `protobuf:"varint,2,opt,name=inflight_requests,json=inflightRequests,proto3" json:"inflight_requests,omitempty"`
+}
+
+func (x *DrainRes) Reset() {
+	*x = DrainRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainRes) ProtoMessage() {}
+
+func (x *DrainRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainRes.ProtoReflect.Descriptor instead.
+func (*DrainRes) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DrainRes) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *DrainRes) GetInflightRequests() int64 {
+	if x != nil {
+		return x.InflightRequests
+	}
+	return 0
+}
+
+type RuntimeStateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RuntimeStateReq) Reset() {
+	*x = RuntimeStateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeStateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeStateReq) ProtoMessage() {}
+
+func (x *RuntimeStateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeStateReq.ProtoReflect.Descriptor instead.
+func (*RuntimeStateReq) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{51}
+}
+
+// AuditChange is a changed invite code field (timestamps as RFC 3339, empty if not set)
+type AuditChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After  string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *AuditChange) Reset() {
+	*x = AuditChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditChange) ProtoMessage() {}
+
+func (x *AuditChange) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditChange.ProtoReflect.Descriptor instead.
+func (*AuditChange) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AuditChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AuditChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// AuditEvent records a mutating invite code operation, audit events are never updated or removed
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operation    AuditEvent_Operation   `protobuf:"varint,2,opt,name=operation,proto3,enum=aribor.AuditEvent_Operation" json:"operation,omitempty"`
+	InviteCodeId string                 `protobuf:"bytes,3,opt,name=invite_code_id,json=inviteCodeId,proto3" json:"invite_code_id,omitempty"`
+	Actor        string                 `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	RequestId    string                 `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Method       string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	ClientIp     string                 `protobuf:"bytes,7,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	ForwardedFor string                 `protobuf:"bytes,8,opt,name=forwarded_for,json=forwardedFor,proto3" json:"forwarded_for,omitempty"`
+	OccurredAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Changes      []*AuditChange         `protobuf:"bytes,10,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOperation() AuditEvent_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return AuditEvent_OPERATION_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetInviteCodeId() string {
+	if x != nil {
+		return x.InviteCodeId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetForwardedFor() string {
+	if x != nil {
+		return x.ForwardedFor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetChanges() []*AuditChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// ListAuditEventsReq lists audit events newest first (all filters optional, limit defaults to 100)
+type ListAuditEventsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InviteCodeId string                 `protobuf:"bytes,1,opt,name=invite_code_id,json=inviteCodeId,proto3" json:"invite_code_id,omitempty"`
+	Actor        string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	From         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"` // inclusive
+	To           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`     // exclusive
+	Limit        int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken    string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+}
+
+func (x *ListAuditEventsReq) Reset() {
+	*x = ListAuditEventsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsReq) ProtoMessage() {}
+
+func (x *ListAuditEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsReq.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsReq) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListAuditEventsReq) GetInviteCodeId() string {
+	if x != nil {
+		return x.InviteCodeId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsReq) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsReq) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditEventsReq) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditEventsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditEventsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuditEventsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events        []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListAuditEventsRes) Reset() {
+	*x = ListAuditEventsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRes) ProtoMessage() {}
+
+func (x *ListAuditEventsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRes.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRes) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListAuditEventsRes) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRes) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UserProfile_PhoneNumber struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number string                `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Type   UserProfile_PhoneType `protobuf:"varint,2,opt,name=type,proto3,enum=aribor.UserProfile_PhoneType" json:"type,omitempty"`
+}
+
+func (x *UserProfile_PhoneNumber) Reset() {
+	*x = UserProfile_PhoneNumber{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserProfile_PhoneNumber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile_PhoneNumber) ProtoMessage() {}
+
+func (x *UserProfile_PhoneNumber) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile_PhoneNumber.ProtoReflect.Descriptor instead.
+func (*UserProfile_PhoneNumber) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *UserProfile_PhoneNumber) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *UserProfile_PhoneNumber) GetType() UserProfile_PhoneType {
+	if x != nil {
+		return x.Type
+	}
+	return UserProfile_UNKNOWN
+}
+
+type UserConfig_Layout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Style            string `protobuf:"bytes,1,opt,name=style,proto3" json:"style,omitempty"`
+	CustomScrollbars bool   `protobuf:"varint,2,opt,name=custom_scrollbars,json=customScrollbars,proto3" json:"custom_scrollbars,omitempty"`
+	ThemeMain        string `protobuf:"bytes,3,opt,name=theme_main,json=themeMain,proto3" json:"theme_main,omitempty"`
+	ThemeNavbar      string `protobuf:"bytes,4,opt,name=theme_navbar,json=themeNavbar,proto3" json:"theme_navbar,omitempty"`
+	ThemeToolbar     string `protobuf:"bytes,5,opt,name=theme_toolbar,json=themeToolbar,proto3" json:"theme_toolbar,omitempty"`
+	ThemeFooter      string `protobuf:"bytes,6,opt,name=theme_footer,json=themeFooter,proto3" json:"theme_footer,omitempty"`
+}
+
+func (x *UserConfig_Layout) Reset() {
+	*x = UserConfig_Layout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserConfig_Layout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserConfig_Layout) ProtoMessage() {}
+
+func (x *UserConfig_Layout) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserConfig_Layout.ProtoReflect.Descriptor instead.
+func (*UserConfig_Layout) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *UserConfig_Layout) GetStyle() string {
+	if x != nil {
+		return x.Style
+	}
+	return ""
+}
+
+func (x *UserConfig_Layout) GetCustomScrollbars() bool {
+	if x != nil {
+		return x.CustomScrollbars
+	}
+	return false
+}
+
+func (x *UserConfig_Layout) GetThemeMain() string {
+	if x != nil {
+		return x.ThemeMain
+	}
+	return ""
+}
+
+func (x *UserConfig_Layout) GetThemeNavbar() string {
+	if x != nil {
+		return x.ThemeNavbar
+	}
+	return ""
+}
+
+func (x *UserConfig_Layout) GetThemeToolbar() string {
+	if x != nil {
+		return x.ThemeToolbar
+	}
+	return ""
+}
+
+func (x *UserConfig_Layout) GetThemeFooter() string {
+	if x != nil {
+		return x.ThemeFooter
+	}
+	return ""
+}
+
+type UserConfig_Layout_LayoutConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scroll string `protobuf:"bytes,1,opt,name=scroll,proto3" json:"scroll,omitempty"`
+	Mode   string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (x *UserConfig_Layout_LayoutConfig) Reset() {
+	*x = UserConfig_Layout_LayoutConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserConfig_Layout_LayoutConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserConfig_Layout_LayoutConfig) ProtoMessage() {}
+
+func (x *UserConfig_Layout_LayoutConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserConfig_Layout_LayoutConfig.ProtoReflect.Descriptor instead.
+func (*UserConfig_Layout_LayoutConfig) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{10, 0, 0}
+}
+
+func (x *UserConfig_Layout_LayoutConfig) GetScroll() string {
+	if x != nil {
+		return x.Scroll
+	}
+	return ""
+}
+
+func (x *UserConfig_Layout_LayoutConfig) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type UserConfig_Layout_LayoutConfig_LayoutBlockConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Display  bool   `protobuf:"varint,2,opt,name=display,proto3" json:"display,omitempty"`
+	Folded   bool   `protobuf:"varint,3,opt,name=folded,proto3" json:"folded,omitempty"`
+}
+
+func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) Reset() {
+	*x = UserConfig_Layout_LayoutConfig_LayoutBlockConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rf_example_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserConfig_Layout_LayoutConfig_LayoutBlockConfig) ProtoMessage() {}
+
+func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_rf_example_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserConfig_Layout_LayoutConfig_LayoutBlockConfig.ProtoReflect.Descriptor instead.
+func (*UserConfig_Layout_LayoutConfig_LayoutBlockConfig) Descriptor() ([]byte, []int) {
+	return file_rf_example_proto_rawDescGZIP(), []int{10, 0, 0, 0}
+}
+
+func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) GetDisplay() bool {
+	if x != nil {
+		return x.Display
+	}
+	return false
+}
+
+func (x *UserConfig_Layout_LayoutConfig_LayoutBlockConfig) GetFolded() bool {
+	if x != nil {
+		return x.Folded
+	}
+	return false
+}
+
+var file_rf_example_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldRules)(nil),
+		Field:         50100,
+		Name:          "aribor.rules",
+		Tag:           "bytes,50100,opt,name=rules",
+		Filename:      "rf_example.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional aribor.FieldRules rules = 50100;
+	E_Rules = &file_rf_example_proto_extTypes[0]
+)
+
+var File_rf_example_proto protoreflect.FileDescriptor
+
+var file_rf_example_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x72, 0x66, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x06, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xca, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x6e, 0x6f, 0x6e, 0x5f, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x6e, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x73, 0x6b, 0x69, 0x70, 0x22, 0x42, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x22, 0xcc, 0x04, 0x0a, 0x0e, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x18, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xa2, 0xbb, 0x18, 0x05, 0x08, 0x01, 0x10,
+	0x80, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x11,
+	0x6d, 0x65, 0x74, 0x61, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xa2, 0xbb, 0x18, 0x04, 0x08, 0x01, 0x20,
+	0x01, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x69, 0x73, 0x54, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x61,
+	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0xa2,
+	0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x57, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x17, 0xa2, 0xbb, 0x18, 0x13, 0x2a, 0x0f, 0x6d,
+	0x65, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x08, 0x01,
+	0x52, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x6f, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x14, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x29,
+	0x0a, 0x11, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x46,
+	0x6f, 0x72, 0x41, 0x70, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x22, 0x86, 0x03, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x73, 0x5f, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x69, 0x73, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe4, 0x02, 0x0a, 0x09,
+	0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x22, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x69, 0x78, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xa6, 0x04, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a,
+	0x0c, 0x73, 0x65, 0x63, 0x5f, 0x70, 0x77, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x50, 0x77, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x20, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x5f, 0x70, 0x77, 0x64, 0x5f, 0x73, 0x61, 0x6c, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x50, 0x77, 0x64, 0x53, 0x61, 0x6c,
+	0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x65, 0x63, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x55, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f,
+	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x72, 0x69,
+	0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x0b, 0x75, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x73, 0x5f, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x69, 0x73, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xef, 0x02, 0x0a, 0x0b,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f, 0x6e, 0x75,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4e,
+	0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x91, 0x06,
+	0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x69, 0x72, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x69, 0x72, 0x74, 0x68, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x43, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d,
+	0x62, 0x69, 0x72, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x69, 0x72, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x26, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x72,
+	0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x72, 0x69,
+	0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x72, 0x69,
+	0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x03, 0x64, 0x6f, 0x62, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x44,
+	0x61, 0x74, 0x65, 0x52, 0x03, 0x64, 0x6f, 0x62, 0x12, 0x44, 0x0a, 0x0d, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x18, 0x12, 0x20, 0x01,
 	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
 	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xef, 0x02,
-	0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f,
-	0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x72, 0x65, 0x65,
-	0x74, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0x91, 0x06, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x72, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x72, 0x74, 0x68, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x72, 0x74, 0x68, 0x43, 0x69, 0x74, 0x79, 0x12, 0x23,
-	0x0a, 0x0d, 0x62, 0x69, 0x72, 0x74, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x69, 0x72, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x76, 0x61, 0x74,
-	0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x73,
-	0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x2d, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
-	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
-	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x03, 0x64, 0x6f,
-	0x62, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72,
-	0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x03, 0x64, 0x6f, 0x62, 0x12, 0x44, 0x0a, 0x0d, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x18, 0x12,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a,
-	0x58, 0x0a, 0x0b, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x38, 0x0a, 0x09, 0x50, 0x68, 0x6f,
-	0x6e, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x4f, 0x42, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12,
-	0x08, 0x0a, 0x04, 0x48, 0x4f, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x4f, 0x52,
-	0x4b, 0x10, 0x03, 0x22, 0xb2, 0x03, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73,
-	0x1a, 0xf5, 0x02, 0x0a, 0x06, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x74, 0x79, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x79, 0x6c,
-	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x6f,
-	0x6c, 0x6c, 0x62, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x72, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a,
-	0x0c, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x76, 0x62, 0x61, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x4e, 0x61, 0x76, 0x62, 0x61, 0x72,
-	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x62, 0x61,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x54, 0x6f,
-	0x6f, 0x6c, 0x62, 0x61, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x66,
-	0x6f, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x68, 0x65,
-	0x6d, 0x65, 0x46, 0x6f, 0x6f, 0x74, 0x65, 0x72, 0x1a, 0x9d, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x79,
-	0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72,
-	0x6f, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x72, 0x6f, 0x6c,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x1a, 0x61, 0x0a, 0x11, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x64, 0x22, 0x35, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22,
-	0x35, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x58, 0x0a,
+	0x0b, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x38, 0x0a, 0x09, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x4f, 0x42, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x48, 0x4f, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x4f, 0x52, 0x4b, 0x10,
+	0x03, 0x22, 0xb2, 0x03, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x63, 0x75, 0x74, 0x73, 0x1a, 0xf5,
+	0x02, 0x0a, 0x06, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x4d, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x68, 0x65, 0x6d, 0x65, 0x5f, 0x6e, 0x61, 0x76, 0x62, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x4e, 0x61, 0x76, 0x62, 0x61, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x62, 0x61, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x54, 0x6f, 0x6f, 0x6c,
+	0x62, 0x61, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x5f, 0x66, 0x6f, 0x6f,
+	0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x68, 0x65, 0x6d, 0x65,
+	0x46, 0x6f, 0x6f, 0x74, 0x65, 0x72, 0x1a, 0x9d, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x6f, 0x6c,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x1a, 0x61, 0x0a, 0x11, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x64, 0x22, 0x35, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x35, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
+	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x1c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x32, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
 	0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
 	0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x1c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
-	0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x35, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22,
-	0x35, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x1f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x22, 0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x22, 0x33, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x4d, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a,
-	0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x4d, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0a,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x43, 0x6f, 0x64, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0a,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x43, 0x6f, 0x64, 0x65, 0x22, 0x4d, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x0a, 0x69,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x35, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x35, 0x0a,
+	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
+	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x1f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x22,
+	0x33, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61,
+	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x4d, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x69,
 	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x16, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76,
 	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
-	0x6f, 0x64, 0x65, 0x22, 0x4d, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
-	0x64, 0x65, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x13, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x51, 0x0a, 0x19, 0x4c, 0x69,
-	0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x53, 0x0a,
-	0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
-	0x64, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x22, 0x4b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x72,
+	0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0x4d, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x72, 0x69,
+	0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x2f,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0x51, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
+	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x22, 0x53, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12,
+	0x36, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x4b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x49,
 	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0a,
 	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x16, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
@@ -2767,62 +4522,353 @@ var file_rf_example_proto_rawDesc = []byte{
 	0x43, 0x6f, 0x64, 0x65, 0x22, 0x0c, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
 	0x65, 0x71, 0x22, 0x26, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
 	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0xb1, 0x02, 0x0a, 0x0f, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a,
-	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x61,
-	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x47, 0x65,
-	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x61, 0x72, 0x69, 0x62,
-	0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x3a, 0x0a,
-	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x72,
-	0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15,
-	0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c,
-	0x65, 0x73, 0x12, 0x13, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x30, 0x01, 0x32, 0xaa,
-	0x04, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x61,
-	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x61, 0x72, 0x69, 0x62,
-	0x6f, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72,
-	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x18, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x10, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x1b, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x61,
-	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x10, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e,
-	0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x61, 0x72, 0x69,
-	0x62, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x72, 0x69,
-	0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x30, 0x01, 0x12, 0x61, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x65, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x21, 0x2e,
-	0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x21, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x73, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72,
-	0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x42, 0x0a, 0x5a, 0x08, 0x2e,
-	0x3b, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x3e, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x06, 0xa2,
+	0xbb, 0x18, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x55, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xa2, 0xbb, 0x18, 0x04, 0x08, 0x01, 0x18, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0xc7, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x40,
+	0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xa2, 0xbb, 0x18, 0x04,
+	0x38, 0x01, 0x08, 0x01, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x31, 0x0a,
+	0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x30, 0x01, 0x52,
+	0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x6b, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x31, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x61, 0x5f,
+	0x66, 0x6f, 0x72, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x20, 0x01, 0x52, 0x0e, 0x6d, 0x65, 0x74, 0x61,
+	0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc6, 0x02,
+	0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x36, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x6f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5c, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x44, 0x45,
+	0x45, 0x4d, 0x45, 0x44, 0x10, 0x04, 0x22, 0x62, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xa2, 0xbb, 0x18, 0x04, 0x08,
+	0x01, 0x18, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x06, 0xa2, 0xbb, 0x18, 0x02, 0x30, 0x01, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x64, 0x0a, 0x13, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x22, 0x8c, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22,
+	0xb3, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x65, 0x64, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x6c,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f,
+	0x72, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0xd3, 0x03, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x33,
+	0x0a, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e,
+	0x53, 0x65, 0x65, 0x64, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x6f, 0x6e, 0x67, 0x6f, 0x64, 0x62, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x6d, 0x6f, 0x6e, 0x67, 0x6f,
+	0x64, 0x62, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb2, 0x03, 0x0a, 0x0f,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x42, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41,
+	0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x8b, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x06, 0xa2, 0xbb, 0x18, 0x02, 0x30, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x53,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x0f, 0x53, 0x65, 0x65, 0x64, 0x46, 0x69, 0x78, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x22, 0x12, 0x0a, 0x10, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x22, 0x61, 0x0a, 0x10, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f,
+	0x72, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x22, 0x45, 0x0a, 0x0f, 0x52, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x3f, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x33, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x22, 0x53, 0x0a, 0x08, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x69, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x22, 0x51, 0x0a, 0x0b, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0xde, 0x03,
+	0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x12, 0x3b, 0x0a,
+	0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x72,
+	0x69, 0x62, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x09, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c,
+	0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45,
+	0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x44, 0x45, 0x45, 0x4d, 0x10, 0x05, 0x22, 0xe9,
+	0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1c, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0xa2, 0xbb,
+	0x18, 0x02, 0x30, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x12, 0x2a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x32, 0xb1, 0x02, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
+	0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x12, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x15, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x61,
+	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x15, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f,
+	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x12,
+	0x37, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x61,
+	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x13, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x30, 0x01, 0x32, 0xfa, 0x04, 0x0a, 0x15, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x4c, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x12, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x61, 0x72,
+	0x69, 0x62, 0x6f, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x72, 0x69, 0x62,
+	0x6f, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x12, 0x49, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x61, 0x72, 0x69,
+	0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x30, 0x01, 0x12,
+	0x4e, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x1b, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x30, 0x01, 0x12,
+	0x34, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x2e,
+	0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x12, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x32, 0xf1, 0x03, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x53, 0x65, 0x65, 0x64, 0x46, 0x69,
+	0x78, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e,
+	0x53, 0x65, 0x65, 0x64, 0x46, 0x69, 0x78, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x17, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x46, 0x69, 0x78,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0d, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x2e, 0x61, 0x72, 0x69, 0x62,
+	0x6f, 0x72, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x12, 0x40, 0x0a,
+	0x0c, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x2e,
+	0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e,
+	0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x05, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x10, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f,
+	0x72, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x61, 0x72, 0x69,
+	0x62, 0x6f, 0x72, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x17, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f,
+	0x72, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x12, 0x5b, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x72, 0x69,
+	0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x61,
+	0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x49,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1a, 0x2e, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e,
+	0x61, 0x72, 0x69, 0x62, 0x6f, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x3a, 0x49, 0x0a, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xb4, 0x87, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x72, 0x69, 0x62,
+	0x6f, 0x72, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x3b, 0x61, 0x72, 0x69, 0x62, 0x6f, 0x72,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2837,121 +4883,190 @@ func file_rf_example_proto_rawDescGZIP() []byte {
 	return file_rf_example_proto_rawDescData
 }
 
-var file_rf_example_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rf_example_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_rf_example_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_rf_example_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_rf_example_proto_goTypes = []interface{}{
-	(UserProfile_PhoneType)(0),             // 0: aribor.UserProfile.PhoneType
-	(*Empty)(nil),                          // 1: aribor.Empty
-	(*Date)(nil),                           // 2: aribor.Date
-	(*UserInviteCode)(nil),                 // 3: aribor.UserInviteCode
-	(*UserInviteCodeFilter)(nil),           // 4: aribor.UserInviteCodeFilter
-	(*UserRole)(nil),                       // 5: aribor.UserRole
-	(*UserGroup)(nil),                      // 6: aribor.UserGroup
-	(*User)(nil),                           // 7: aribor.User
-	(*UserAddress)(nil),                    // 8: aribor.UserAddress
-	(*UserProfile)(nil),                    // 9: aribor.UserProfile
-	(*UserConfig)(nil),                     // 10: aribor.UserConfig
-	(*CreateRoleReq)(nil),                  // 11: aribor.CreateRoleReq
-	(*CreateRoleRes)(nil),                  // 12: aribor.CreateRoleRes
-	(*GetRoleReq)(nil),                     // 13: aribor.GetRoleReq
-	(*GetRoleRes)(nil),                     // 14: aribor.GetRoleRes
-	(*UpdateRoleReq)(nil),                  // 15: aribor.UpdateRoleReq
-	(*UpdateRoleRes)(nil),                  // 16: aribor.UpdateRoleRes
-	(*DeleteRoleReq)(nil),                  // 17: aribor.DeleteRoleReq
-	(*DeleteRoleRes)(nil),                  // 18: aribor.DeleteRoleRes
-	(*ListRoleReq)(nil),                    // 19: aribor.ListRoleReq
-	(*ListRoleRes)(nil),                    // 20: aribor.ListRoleRes
-	(*CreateInviteCodeReq)(nil),            // 21: aribor.CreateInviteCodeReq
-	(*CreateInviteCodeRes)(nil),            // 22: aribor.CreateInviteCodeRes
-	(*GetInviteCodeReq)(nil),               // 23: aribor.GetInviteCodeReq
-	(*GetInviteCodeRes)(nil),               // 24: aribor.GetInviteCodeRes
-	(*UpdateInviteCodeReq)(nil),            // 25: aribor.UpdateInviteCodeReq
-	(*UpdateInviteCodeRes)(nil),            // 26: aribor.UpdateInviteCodeRes
-	(*DeleteInviteCodeReq)(nil),            // 27: aribor.DeleteInviteCodeReq
-	(*DeleteInviteCodeRes)(nil),            // 28: aribor.DeleteInviteCodeRes
-	(*ListFilteredInviteCodeReq)(nil),      // 29: aribor.ListFilteredInviteCodeReq
-	(*ListFilteredInviteCodeRes)(nil),      // 30: aribor.ListFilteredInviteCodeRes
-	(*ListInviteCodeReq)(nil),              // 31: aribor.ListInviteCodeReq
-	(*ListInviteCodeRes)(nil),              // 32: aribor.ListInviteCodeRes
-	(*VersionReq)(nil),                     // 33: aribor.VersionReq
-	(*VersionRes)(nil),                     // 34: aribor.VersionRes
-	(*UserProfile_PhoneNumber)(nil),        // 35: aribor.UserProfile.PhoneNumber
-	(*UserConfig_Layout)(nil),              // 36: aribor.UserConfig.Layout
-	(*UserConfig_Layout_LayoutConfig)(nil), // 37: aribor.UserConfig.Layout.LayoutConfig
-	(*UserConfig_Layout_LayoutConfig_LayoutBlockConfig)(nil), // 38: aribor.UserConfig.Layout.LayoutConfig.LayoutBlockConfig
-	(*timestamppb.Timestamp)(nil),                            // 39: google.protobuf.Timestamp
+	(UserProfile_PhoneType)(0),                               // 0: aribor.UserProfile.PhoneType
+	(WatchInviteCodesRes_EventType)(0),                       // 1: aribor.WatchInviteCodesRes.EventType
+	(AuditEvent_Operation)(0),                                // 2: aribor.AuditEvent.Operation
+	(*Empty)(nil),                                            // 3: aribor.Empty
+	(*FieldRules)(nil),                                       // 4: aribor.FieldRules
+	(*Date)(nil),                                             // 5: aribor.Date
+	(*UserInviteCode)(nil),                                   // 6: aribor.UserInviteCode
+	(*UserInviteCodeFilter)(nil),                             // 7: aribor.UserInviteCodeFilter
+	(*UserRole)(nil),                                         // 8: aribor.UserRole
+	(*UserGroup)(nil),                                        // 9: aribor.UserGroup
+	(*User)(nil),                                             // 10: aribor.User
+	(*UserAddress)(nil),                                      // 11: aribor.UserAddress
+	(*UserProfile)(nil),                                      // 12: aribor.UserProfile
+	(*UserConfig)(nil),                                       // 13: aribor.UserConfig
+	(*CreateRoleReq)(nil),                                    // 14: aribor.CreateRoleReq
+	(*CreateRoleRes)(nil),                                    // 15: aribor.CreateRoleRes
+	(*GetRoleReq)(nil),                                       // 16: aribor.GetRoleReq
+	(*GetRoleRes)(nil),                                       // 17: aribor.GetRoleRes
+	(*UpdateRoleReq)(nil),                                    // 18: aribor.UpdateRoleReq
+	(*UpdateRoleRes)(nil),                                    // 19: aribor.UpdateRoleRes
+	(*DeleteRoleReq)(nil),                                    // 20: aribor.DeleteRoleReq
+	(*DeleteRoleRes)(nil),                                    // 21: aribor.DeleteRoleRes
+	(*ListRoleReq)(nil),                                      // 22: aribor.ListRoleReq
+	(*ListRoleRes)(nil),                                      // 23: aribor.ListRoleRes
+	(*CreateInviteCodeRes)(nil),                              // 24: aribor.CreateInviteCodeRes
+	(*GetInviteCodeRes)(nil),                                 // 25: aribor.GetInviteCodeRes
+	(*UpdateInviteCodeRes)(nil),                              // 26: aribor.UpdateInviteCodeRes
+	(*DeleteInviteCodeRes)(nil),                              // 27: aribor.DeleteInviteCodeRes
+	(*ListFilteredInviteCodeReq)(nil),                        // 28: aribor.ListFilteredInviteCodeReq
+	(*ListFilteredInviteCodeRes)(nil),                        // 29: aribor.ListFilteredInviteCodeRes
+	(*ListInviteCodeRes)(nil),                                // 30: aribor.ListInviteCodeRes
+	(*VersionReq)(nil),                                       // 31: aribor.VersionReq
+	(*VersionRes)(nil),                                       // 32: aribor.VersionRes
+	(*CreateInviteCodeReq)(nil),                              // 33: aribor.CreateInviteCodeReq
+	(*GetInviteCodeReq)(nil),                                 // 34: aribor.GetInviteCodeReq
+	(*ListInviteCodeReq)(nil),                                // 35: aribor.ListInviteCodeReq
+	(*UpdateInviteCodeReq)(nil),                              // 36: aribor.UpdateInviteCodeReq
+	(*WatchInviteCodesReq)(nil),                              // 37: aribor.WatchInviteCodesReq
+	(*WatchInviteCodesRes)(nil),                              // 38: aribor.WatchInviteCodesRes
+	(*DeleteInviteCodeReq)(nil),                              // 39: aribor.DeleteInviteCodeReq
+	(*FaultInjectionState)(nil),                              // 40: aribor.FaultInjectionState
+	(*SeedRoleReport)(nil),                                   // 41: aribor.SeedRoleReport
+	(*SeedFixturesRes)(nil),                                  // 42: aribor.SeedFixturesRes
+	(*RuntimeStateRes)(nil),                                  // 43: aribor.RuntimeStateRes
+	(*WebhookDelivery)(nil),                                  // 44: aribor.WebhookDelivery
+	(*ListWebhookDeliveriesReq)(nil),                         // 45: aribor.ListWebhookDeliveriesReq
+	(*ListWebhookDeliveriesRes)(nil),                         // 46: aribor.ListWebhookDeliveriesRes
+	(*SeedFixturesReq)(nil),                                  // 47: aribor.SeedFixturesReq
+	(*ToggleLatencyReq)(nil),                                 // 48: aribor.ToggleLatencyReq
+	(*ToggleLatencyRes)(nil),                                 // 49: aribor.ToggleLatencyRes
+	(*ReloadConfigReq)(nil),                                  // 50: aribor.ReloadConfigReq
+	(*ReloadConfigRes)(nil),                                  // 51: aribor.ReloadConfigRes
+	(*DrainReq)(nil),                                         // 52: aribor.DrainReq
+	(*DrainRes)(nil),                                         // 53: aribor.DrainRes
+	(*RuntimeStateReq)(nil),                                  // 54: aribor.RuntimeStateReq
+	(*AuditChange)(nil),                                      // 55: aribor.AuditChange
+	(*AuditEvent)(nil),                                       // 56: aribor.AuditEvent
+	(*ListAuditEventsReq)(nil),                               // 57: aribor.ListAuditEventsReq
+	(*ListAuditEventsRes)(nil),                               // 58: aribor.ListAuditEventsRes
+	(*UserProfile_PhoneNumber)(nil),                          // 59: aribor.UserProfile.PhoneNumber
+	(*UserConfig_Layout)(nil),                                // 60: aribor.UserConfig.Layout
+	(*UserConfig_Layout_LayoutConfig)(nil),                   // 61: aribor.UserConfig.Layout.LayoutConfig
+	(*UserConfig_Layout_LayoutConfig_LayoutBlockConfig)(nil), // 62: aribor.UserConfig.Layout.LayoutConfig.LayoutBlockConfig
+	(*timestamppb.Timestamp)(nil),                            // 63: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                            // 64: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),                              // 65: google.protobuf.Duration
+	(*descriptorpb.FieldOptions)(nil),                        // 66: google.protobuf.FieldOptions
 }
 var file_rf_example_proto_depIdxs = []int32{
-	39, // 0: aribor.UserInviteCode.meta_valid_from:type_name -> google.protobuf.Timestamp
-	39, // 1: aribor.UserInviteCode.meta_valid_to:type_name -> google.protobuf.Timestamp
-	39, // 2: aribor.UserInviteCode.created_at:type_name -> google.protobuf.Timestamp
-	39, // 3: aribor.UserInviteCode.deleted_at:type_name -> google.protobuf.Timestamp
-	39, // 4: aribor.UserRole.created_at:type_name -> google.protobuf.Timestamp
-	39, // 5: aribor.UserRole.updated_at:type_name -> google.protobuf.Timestamp
-	39, // 6: aribor.UserRole.deleted_at:type_name -> google.protobuf.Timestamp
-	7,  // 7: aribor.UserGroup.users:type_name -> aribor.User
-	39, // 8: aribor.UserGroup.created_at:type_name -> google.protobuf.Timestamp
-	39, // 9: aribor.UserGroup.updated_at:type_name -> google.protobuf.Timestamp
-	39, // 10: aribor.UserGroup.deleted_at:type_name -> google.protobuf.Timestamp
-	5,  // 11: aribor.User.user_role:type_name -> aribor.UserRole
-	9,  // 12: aribor.User.user_profile:type_name -> aribor.UserProfile
-	39, // 13: aribor.User.created_at:type_name -> google.protobuf.Timestamp
-	39, // 14: aribor.User.updated_at:type_name -> google.protobuf.Timestamp
-	39, // 15: aribor.User.deleted_at:type_name -> google.protobuf.Timestamp
-	39, // 16: aribor.UserAddress.created_at:type_name -> google.protobuf.Timestamp
-	39, // 17: aribor.UserAddress.updated_at:type_name -> google.protobuf.Timestamp
-	39, // 18: aribor.UserAddress.deleted_at:type_name -> google.protobuf.Timestamp
-	8,  // 19: aribor.UserProfile.address:type_name -> aribor.UserAddress
-	10, // 20: aribor.UserProfile.settings:type_name -> aribor.UserConfig
-	2,  // 21: aribor.UserProfile.dob:type_name -> aribor.Date
-	35, // 22: aribor.UserProfile.phone_numbers:type_name -> aribor.UserProfile.PhoneNumber
-	39, // 23: aribor.UserProfile.created_at:type_name -> google.protobuf.Timestamp
-	39, // 24: aribor.UserProfile.updated_at:type_name -> google.protobuf.Timestamp
-	39, // 25: aribor.UserProfile.deleted_at:type_name -> google.protobuf.Timestamp
-	5,  // 26: aribor.CreateRoleReq.role:type_name -> aribor.UserRole
-	5,  // 27: aribor.CreateRoleRes.role:type_name -> aribor.UserRole
-	5,  // 28: aribor.GetRoleRes.role:type_name -> aribor.UserRole
-	5,  // 29: aribor.UpdateRoleReq.role:type_name -> aribor.UserRole
-	5,  // 30: aribor.UpdateRoleRes.role:type_name -> aribor.UserRole
-	5,  // 31: aribor.ListRoleRes.role:type_name -> aribor.UserRole
-	3,  // 32: aribor.CreateInviteCodeReq.inviteCode:type_name -> aribor.UserInviteCode
-	3,  // 33: aribor.CreateInviteCodeRes.inviteCode:type_name -> aribor.UserInviteCode
-	3,  // 34: aribor.GetInviteCodeRes.inviteCode:type_name -> aribor.UserInviteCode
-	3,  // 35: aribor.UpdateInviteCodeReq.inviteCode:type_name -> aribor.UserInviteCode
-	3,  // 36: aribor.UpdateInviteCodeRes.inviteCode:type_name -> aribor.UserInviteCode
-	4,  // 37: aribor.ListFilteredInviteCodeReq.filter:type_name -> aribor.UserInviteCodeFilter
-	3,  // 38: aribor.ListFilteredInviteCodeRes.inviteCode:type_name -> aribor.UserInviteCode
-	3,  // 39: aribor.ListInviteCodeRes.inviteCode:type_name -> aribor.UserInviteCode
-	0,  // 40: aribor.UserProfile.PhoneNumber.type:type_name -> aribor.UserProfile.PhoneType
-	11, // 41: aribor.UserRoleService.CreateRole:input_type -> aribor.CreateRoleReq
-	13, // 42: aribor.UserRoleService.GetRole:input_type -> aribor.GetRoleReq
-	15, // 43: aribor.UserRoleService.UpdateRole:input_type -> aribor.UpdateRoleReq
-	17, // 44: aribor.UserRoleService.DeleteRole:input_type -> aribor.DeleteRoleReq
-	19, // 45: aribor.UserRoleService.ListRoles:input_type -> aribor.ListRoleReq
-	21, // 46: aribor.UserInviteCodeService.CreateInviteCode:input_type -> aribor.CreateInviteCodeReq
-	23, // 47: aribor.UserInviteCodeService.GetInviteCode:input_type -> aribor.GetInviteCodeReq
-	25, // 48: aribor.UserInviteCodeService.UpdateInviteCode:input_type -> aribor.UpdateInviteCodeReq
-	27, // 49: aribor.UserInviteCodeService.DeleteInviteCode:input_type -> aribor.DeleteInviteCodeReq
-	31, // 50: aribor.UserInviteCodeService.ListInviteCodes:input_type -> aribor.ListInviteCodeReq
-	29, // 51: aribor.UserInviteCodeService.ListFilteredInviteCodes:input_type -> aribor.ListFilteredInviteCodeReq
-	33, // 52: aribor.UserInviteCodeService.GetVersion:input_type -> aribor.VersionReq
-	12, // 53: aribor.UserRoleService.CreateRole:output_type -> aribor.CreateRoleRes
-	14, // 54: aribor.UserRoleService.GetRole:output_type -> aribor.GetRoleRes
-	16, // 55: aribor.UserRoleService.UpdateRole:output_type -> aribor.UpdateRoleRes
-	18, // 56: aribor.UserRoleService.DeleteRole:output_type -> aribor.DeleteRoleRes
-	20, // 57: aribor.UserRoleService.ListRoles:output_type -> aribor.ListRoleRes
-	22, // 58: aribor.UserInviteCodeService.CreateInviteCode:output_type -> aribor.CreateInviteCodeRes
-	24, // 59: aribor.UserInviteCodeService.GetInviteCode:output_type -> aribor.GetInviteCodeRes
-	26, // 60: aribor.UserInviteCodeService.UpdateInviteCode:output_type -> aribor.UpdateInviteCodeRes
-	28, // 61: aribor.UserInviteCodeService.DeleteInviteCode:output_type -> aribor.DeleteInviteCodeRes
-	32, // 62: aribor.UserInviteCodeService.ListInviteCodes:output_type -> aribor.ListInviteCodeRes
-	30, // 63: aribor.UserInviteCodeService.ListFilteredInviteCodes:output_type -> aribor.ListFilteredInviteCodeRes
-	34, // 64: aribor.UserInviteCodeService.GetVersion:output_type -> aribor.VersionRes
-	53, // [53:65] is the sub-list for method output_type
-	41, // [41:53] is the sub-list for method input_type
-	41, // [41:41] is the sub-list for extension type_name
-	41, // [41:41] is the sub-list for extension extendee
-	0,  // [0:41] is the sub-list for field type_name
+	63, // 0: aribor.UserInviteCode.meta_valid_from:type_name -> google.protobuf.Timestamp
+	63, // 1: aribor.UserInviteCode.meta_valid_to:type_name -> google.protobuf.Timestamp
+	63, // 2: aribor.UserInviteCode.created_at:type_name -> google.protobuf.Timestamp
+	63, // 3: aribor.UserInviteCode.deleted_at:type_name -> google.protobuf.Timestamp
+	63, // 4: aribor.UserInviteCode.updated_at:type_name -> google.protobuf.Timestamp
+	63, // 5: aribor.UserRole.created_at:type_name -> google.protobuf.Timestamp
+	63, // 6: aribor.UserRole.updated_at:type_name -> google.protobuf.Timestamp
+	63, // 7: aribor.UserRole.deleted_at:type_name -> google.protobuf.Timestamp
+	10, // 8: aribor.UserGroup.users:type_name -> aribor.User
+	63, // 9: aribor.UserGroup.created_at:type_name -> google.protobuf.Timestamp
+	63, // 10: aribor.UserGroup.updated_at:type_name -> google.protobuf.Timestamp
+	63, // 11: aribor.UserGroup.deleted_at:type_name -> google.protobuf.Timestamp
+	8,  // 12: aribor.User.user_role:type_name -> aribor.UserRole
+	12, // 13: aribor.User.user_profile:type_name -> aribor.UserProfile
+	63, // 14: aribor.User.created_at:type_name -> google.protobuf.Timestamp
+	63, // 15: aribor.User.updated_at:type_name -> google.protobuf.Timestamp
+	63, // 16: aribor.User.deleted_at:type_name -> google.protobuf.Timestamp
+	63, // 17: aribor.UserAddress.created_at:type_name -> google.protobuf.Timestamp
+	63, // 18: aribor.UserAddress.updated_at:type_name -> google.protobuf.Timestamp
+	63, // 19: aribor.UserAddress.deleted_at:type_name -> google.protobuf.Timestamp
+	11, // 20: aribor.UserProfile.address:type_name -> aribor.UserAddress
+	13, // 21: aribor.UserProfile.settings:type_name -> aribor.UserConfig
+	5,  // 22: aribor.UserProfile.dob:type_name -> aribor.Date
+	59, // 23: aribor.UserProfile.phone_numbers:type_name -> aribor.UserProfile.PhoneNumber
+	63, // 24: aribor.UserProfile.created_at:type_name -> google.protobuf.Timestamp
+	63, // 25: aribor.UserProfile.updated_at:type_name -> google.protobuf.Timestamp
+	63, // 26: aribor.UserProfile.deleted_at:type_name -> google.protobuf.Timestamp
+	8,  // 27: aribor.CreateRoleReq.role:type_name -> aribor.UserRole
+	8,  // 28: aribor.CreateRoleRes.role:type_name -> aribor.UserRole
+	8,  // 29: aribor.GetRoleRes.role:type_name -> aribor.UserRole
+	8,  // 30: aribor.UpdateRoleReq.role:type_name -> aribor.UserRole
+	8,  // 31: aribor.UpdateRoleRes.role:type_name -> aribor.UserRole
+	8,  // 32: aribor.ListRoleRes.role:type_name -> aribor.UserRole
+	6,  // 33: aribor.CreateInviteCodeRes.inviteCode:type_name -> aribor.UserInviteCode
+	6,  // 34: aribor.GetInviteCodeRes.inviteCode:type_name -> aribor.UserInviteCode
+	6,  // 35: aribor.UpdateInviteCodeRes.inviteCode:type_name -> aribor.UserInviteCode
+	7,  // 36: aribor.ListFilteredInviteCodeReq.filter:type_name -> aribor.UserInviteCodeFilter
+	6,  // 37: aribor.ListFilteredInviteCodeRes.inviteCode:type_name -> aribor.UserInviteCode
+	6,  // 38: aribor.ListInviteCodeRes.inviteCode:type_name -> aribor.UserInviteCode
+	6,  // 39: aribor.CreateInviteCodeReq.inviteCode:type_name -> aribor.UserInviteCode
+	6,  // 40: aribor.UpdateInviteCodeReq.inviteCode:type_name -> aribor.UserInviteCode
+	64, // 41: aribor.UpdateInviteCodeReq.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 42: aribor.WatchInviteCodesRes.type:type_name -> aribor.WatchInviteCodesRes.EventType
+	6,  // 43: aribor.WatchInviteCodesRes.inviteCode:type_name -> aribor.UserInviteCode
+	63, // 44: aribor.WatchInviteCodesRes.occurred_at:type_name -> google.protobuf.Timestamp
+	63, // 45: aribor.SeedFixturesRes.started_at:type_name -> google.protobuf.Timestamp
+	63, // 46: aribor.SeedFixturesRes.finished_at:type_name -> google.protobuf.Timestamp
+	41, // 47: aribor.SeedFixturesRes.roles:type_name -> aribor.SeedRoleReport
+	40, // 48: aribor.RuntimeStateRes.faults:type_name -> aribor.FaultInjectionState
+	42, // 49: aribor.RuntimeStateRes.last_seed:type_name -> aribor.SeedFixturesRes
+	63, // 50: aribor.RuntimeStateRes.started_at:type_name -> google.protobuf.Timestamp
+	63, // 51: aribor.RuntimeStateRes.mongodb_changed_at:type_name -> google.protobuf.Timestamp
+	63, // 52: aribor.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	63, // 53: aribor.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	63, // 54: aribor.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	44, // 55: aribor.ListWebhookDeliveriesRes.deliveries:type_name -> aribor.WebhookDelivery
+	40, // 56: aribor.ToggleLatencyRes.faults:type_name -> aribor.FaultInjectionState
+	65, // 57: aribor.DrainReq.timeout:type_name -> google.protobuf.Duration
+	2,  // 58: aribor.AuditEvent.operation:type_name -> aribor.AuditEvent.Operation
+	63, // 59: aribor.AuditEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	55, // 60: aribor.AuditEvent.changes:type_name -> aribor.AuditChange
+	63, // 61: aribor.ListAuditEventsReq.from:type_name -> google.protobuf.Timestamp
+	63, // 62: aribor.ListAuditEventsReq.to:type_name -> google.protobuf.Timestamp
+	56, // 63: aribor.ListAuditEventsRes.events:type_name -> aribor.AuditEvent
+	0,  // 64: aribor.UserProfile.PhoneNumber.type:type_name -> aribor.UserProfile.PhoneType
+	66, // 65: aribor.rules:extendee -> google.protobuf.FieldOptions
+	4,  // 66: aribor.rules:type_name -> aribor.FieldRules
+	14, // 67: aribor.UserRoleService.CreateRole:input_type -> aribor.CreateRoleReq
+	16, // 68: aribor.UserRoleService.GetRole:input_type -> aribor.GetRoleReq
+	18, // 69: aribor.UserRoleService.UpdateRole:input_type -> aribor.UpdateRoleReq
+	20, // 70: aribor.UserRoleService.DeleteRole:input_type -> aribor.DeleteRoleReq
+	22, // 71: aribor.UserRoleService.ListRoles:input_type -> aribor.ListRoleReq
+	33, // 72: aribor.UserInviteCodeService.CreateInviteCode:input_type -> aribor.CreateInviteCodeReq
+	34, // 73: aribor.UserInviteCodeService.GetInviteCode:input_type -> aribor.GetInviteCodeReq
+	36, // 74: aribor.UserInviteCodeService.UpdateInviteCode:input_type -> aribor.UpdateInviteCodeReq
+	39, // 75: aribor.UserInviteCodeService.DeleteInviteCode:input_type -> aribor.DeleteInviteCodeReq
+	35, // 76: aribor.UserInviteCodeService.ListInviteCodes:input_type -> aribor.ListInviteCodeReq
+	28, // 77: aribor.UserInviteCodeService.ListFilteredInviteCodes:input_type -> aribor.ListFilteredInviteCodeReq
+	37, // 78: aribor.UserInviteCodeService.WatchInviteCodes:input_type -> aribor.WatchInviteCodesReq
+	31, // 79: aribor.UserInviteCodeService.GetVersion:input_type -> aribor.VersionReq
+	47, // 80: aribor.AdminService.SeedFixtures:input_type -> aribor.SeedFixturesReq
+	48, // 81: aribor.AdminService.ToggleLatency:input_type -> aribor.ToggleLatencyReq
+	50, // 82: aribor.AdminService.ReloadConfig:input_type -> aribor.ReloadConfigReq
+	52, // 83: aribor.AdminService.Drain:input_type -> aribor.DrainReq
+	54, // 84: aribor.AdminService.GetRuntimeState:input_type -> aribor.RuntimeStateReq
+	45, // 85: aribor.AdminService.ListWebhookDeliveries:input_type -> aribor.ListWebhookDeliveriesReq
+	57, // 86: aribor.AdminService.ListAuditEvents:input_type -> aribor.ListAuditEventsReq
+	15, // 87: aribor.UserRoleService.CreateRole:output_type -> aribor.CreateRoleRes
+	17, // 88: aribor.UserRoleService.GetRole:output_type -> aribor.GetRoleRes
+	19, // 89: aribor.UserRoleService.UpdateRole:output_type -> aribor.UpdateRoleRes
+	21, // 90: aribor.UserRoleService.DeleteRole:output_type -> aribor.DeleteRoleRes
+	23, // 91: aribor.UserRoleService.ListRoles:output_type -> aribor.ListRoleRes
+	24, // 92: aribor.UserInviteCodeService.CreateInviteCode:output_type -> aribor.CreateInviteCodeRes
+	25, // 93: aribor.UserInviteCodeService.GetInviteCode:output_type -> aribor.GetInviteCodeRes
+	26, // 94: aribor.UserInviteCodeService.UpdateInviteCode:output_type -> aribor.UpdateInviteCodeRes
+	27, // 95: aribor.UserInviteCodeService.DeleteInviteCode:output_type -> aribor.DeleteInviteCodeRes
+	30, // 96: aribor.UserInviteCodeService.ListInviteCodes:output_type -> aribor.ListInviteCodeRes
+	29, // 97: aribor.UserInviteCodeService.ListFilteredInviteCodes:output_type -> aribor.ListFilteredInviteCodeRes
+	38, // 98: aribor.UserInviteCodeService.WatchInviteCodes:output_type -> aribor.WatchInviteCodesRes
+	32, // 99: aribor.UserInviteCodeService.GetVersion:output_type -> aribor.VersionRes
+	42, // 100: aribor.AdminService.SeedFixtures:output_type -> aribor.SeedFixturesRes
+	49, // 101: aribor.AdminService.ToggleLatency:output_type -> aribor.ToggleLatencyRes
+	51, // 102: aribor.AdminService.ReloadConfig:output_type -> aribor.ReloadConfigRes
+	53, // 103: aribor.AdminService.Drain:output_type -> aribor.DrainRes
+	43, // 104: aribor.AdminService.GetRuntimeState:output_type -> aribor.RuntimeStateRes
+	46, // 105: aribor.AdminService.ListWebhookDeliveries:output_type -> aribor.ListWebhookDeliveriesRes
+	58, // 106: aribor.AdminService.ListAuditEvents:output_type -> aribor.ListAuditEventsRes
+	87, // [87:107] is the sub-list for method output_type
+	67, // [67:87] is the sub-list for method input_type
+	66, // [66:67] is the sub-list for extension type_name
+	65, // [65:66] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_rf_example_proto_init() }
@@ -2972,8 +5087,80 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Date); i {
+		file_rf_example_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Date); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInviteCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserInviteCodeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2984,8 +5171,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInviteCode); i {
+		file_rf_example_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAddress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2996,8 +5183,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserInviteCodeFilter); i {
+		file_rf_example_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3008,8 +5195,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserRole); i {
+		file_rf_example_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3020,8 +5207,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserGroup); i {
+		file_rf_example_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoleReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3032,8 +5219,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_rf_example_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoleRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3044,8 +5231,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAddress); i {
+		file_rf_example_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoleReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3056,8 +5243,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserProfile); i {
+		file_rf_example_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoleRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3068,8 +5255,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserConfig); i {
+		file_rf_example_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3080,8 +5267,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoleReq); i {
+		file_rf_example_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3092,8 +5279,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoleRes); i {
+		file_rf_example_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRoleReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3104,8 +5291,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoleReq); i {
+		file_rf_example_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRoleRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3116,8 +5303,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoleRes); i {
+		file_rf_example_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoleReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3128,8 +5315,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRoleReq); i {
+		file_rf_example_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoleRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3140,8 +5327,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateRoleRes); i {
+		file_rf_example_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInviteCodeRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3152,8 +5339,200 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRoleReq); i {
+		file_rf_example_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInviteCodeRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateInviteCodeRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInviteCodeRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilteredInviteCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilteredInviteCodeRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInviteCodeRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInviteCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInviteCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInviteCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateInviteCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchInviteCodesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchInviteCodesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInviteCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultInjectionState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rf_example_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeedRoleReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3164,8 +5543,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRoleRes); i {
+		file_rf_example_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeedFixturesRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3176,8 +5555,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRoleReq); i {
+		file_rf_example_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeStateRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3188,8 +5567,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRoleRes); i {
+		file_rf_example_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3200,8 +5579,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInviteCodeReq); i {
+		file_rf_example_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3212,8 +5591,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInviteCodeRes); i {
+		file_rf_example_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3224,8 +5603,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInviteCodeReq); i {
+		file_rf_example_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeedFixturesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3236,8 +5615,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInviteCodeRes); i {
+		file_rf_example_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleLatencyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3248,8 +5627,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateInviteCodeReq); i {
+		file_rf_example_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleLatencyRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3260,8 +5639,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateInviteCodeRes); i {
+		file_rf_example_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3272,8 +5651,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteInviteCodeReq); i {
+		file_rf_example_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3284,8 +5663,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteInviteCodeRes); i {
+		file_rf_example_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3296,8 +5675,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFilteredInviteCodeReq); i {
+		file_rf_example_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3308,8 +5687,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFilteredInviteCodeRes); i {
+		file_rf_example_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeStateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3320,8 +5699,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListInviteCodeReq); i {
+		file_rf_example_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3332,8 +5711,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListInviteCodeRes); i {
+		file_rf_example_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3344,8 +5723,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionReq); i {
+		file_rf_example_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3356,8 +5735,8 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionRes); i {
+		file_rf_example_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3368,7 +5747,7 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_rf_example_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserProfile_PhoneNumber); i {
 			case 0:
 				return &v.state
@@ -3380,7 +5759,7 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_rf_example_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserConfig_Layout); i {
 			case 0:
 				return &v.state
@@ -3392,7 +5771,7 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_rf_example_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserConfig_Layout_LayoutConfig); i {
 			case 0:
 				return &v.state
@@ -3404,7 +5783,7 @@ func file_rf_example_proto_init() {
 				return nil
 			}
 		}
-		file_rf_example_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_rf_example_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserConfig_Layout_LayoutConfig_LayoutBlockConfig); i {
 			case 0:
 				return &v.state
@@ -3422,15 +5801,16 @@ func file_rf_example_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rf_example_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   38,
-			NumExtensions: 0,
-			NumServices:   2,
+			NumEnums:      3,
+			NumMessages:   60,
+			NumExtensions: 1,
+			NumServices:   3,
 		},
 		GoTypes:           file_rf_example_proto_goTypes,
 		DependencyIndexes: file_rf_example_proto_depIdxs,
 		EnumInfos:         file_rf_example_proto_enumTypes,
 		MessageInfos:      file_rf_example_proto_msgTypes,
+		ExtensionInfos:    file_rf_example_proto_extTypes,
 	}.Build()
 	File_rf_example_proto = out.File
 	file_rf_example_proto_rawDesc = nil
@@ -3700,6 +6080,7 @@ type UserInviteCodeServiceClient interface {
 	DeleteInviteCode(ctx context.Context, in *DeleteInviteCodeReq, opts ...grpc.CallOption) (*DeleteInviteCodeRes, error)
 	ListInviteCodes(ctx context.Context, in *ListInviteCodeReq, opts ...grpc.CallOption) (UserInviteCodeService_ListInviteCodesClient, error)
 	ListFilteredInviteCodes(ctx context.Context, in *ListFilteredInviteCodeReq, opts ...grpc.CallOption) (UserInviteCodeService_ListFilteredInviteCodesClient, error)
+	WatchInviteCodes(ctx context.Context, in *WatchInviteCodesReq, opts ...grpc.CallOption) (UserInviteCodeService_WatchInviteCodesClient, error)
 	GetVersion(ctx context.Context, in *VersionReq, opts ...grpc.CallOption) (*VersionRes, error)
 }
 
@@ -3811,6 +6192,38 @@ func (x *userInviteCodeServiceListFilteredInviteCodesClient) Recv() (*ListFilter
 	return m, nil
 }
 
+func (c *userInviteCodeServiceClient) WatchInviteCodes(ctx context.Context, in *WatchInviteCodesReq, opts ...grpc.CallOption) (UserInviteCodeService_WatchInviteCodesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UserInviteCodeService_serviceDesc.Streams[2], "/aribor.UserInviteCodeService/WatchInviteCodes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userInviteCodeServiceWatchInviteCodesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserInviteCodeService_WatchInviteCodesClient interface {
+	Recv() (*WatchInviteCodesRes, error)
+	grpc.ClientStream
+}
+
+type userInviteCodeServiceWatchInviteCodesClient struct {
+	grpc.ClientStream
+}
+
+func (x *userInviteCodeServiceWatchInviteCodesClient) Recv() (*WatchInviteCodesRes, error) {
+	m := new(WatchInviteCodesRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *userInviteCodeServiceClient) GetVersion(ctx context.Context, in *VersionReq, opts ...grpc.CallOption) (*VersionRes, error) {
 	out := new(VersionRes)
 	err := c.cc.Invoke(ctx, "/aribor.UserInviteCodeService/GetVersion", in, out, opts...)
@@ -3828,6 +6241,7 @@ type UserInviteCodeServiceServer interface {
 	DeleteInviteCode(context.Context, *DeleteInviteCodeReq) (*DeleteInviteCodeRes, error)
 	ListInviteCodes(*ListInviteCodeReq, UserInviteCodeService_ListInviteCodesServer) error
 	ListFilteredInviteCodes(*ListFilteredInviteCodeReq, UserInviteCodeService_ListFilteredInviteCodesServer) error
+	WatchInviteCodes(*WatchInviteCodesReq, UserInviteCodeService_WatchInviteCodesServer) error
 	GetVersion(context.Context, *VersionReq) (*VersionRes, error)
 }
 
@@ -3853,6 +6267,9 @@ func (*UnimplementedUserInviteCodeServiceServer) ListInviteCodes(*ListInviteCode
 func (*UnimplementedUserInviteCodeServiceServer) ListFilteredInviteCodes(*ListFilteredInviteCodeReq, UserInviteCodeService_ListFilteredInviteCodesServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListFilteredInviteCodes not implemented")
 }
+func (*UnimplementedUserInviteCodeServiceServer) WatchInviteCodes(*WatchInviteCodesReq, UserInviteCodeService_WatchInviteCodesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInviteCodes not implemented")
+}
 func (*UnimplementedUserInviteCodeServiceServer) GetVersion(context.Context, *VersionReq) (*VersionRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
 }
@@ -3975,6 +6392,27 @@ func (x *userInviteCodeServiceListFilteredInviteCodesServer) Send(m *ListFiltere
 	return x.ServerStream.SendMsg(m)
 }
 
+func _UserInviteCodeService_WatchInviteCodes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInviteCodesReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserInviteCodeServiceServer).WatchInviteCodes(m, &userInviteCodeServiceWatchInviteCodesServer{stream})
+}
+
+type UserInviteCodeService_WatchInviteCodesServer interface {
+	Send(*WatchInviteCodesRes) error
+	grpc.ServerStream
+}
+
+type userInviteCodeServiceWatchInviteCodesServer struct {
+	grpc.ServerStream
+}
+
+func (x *userInviteCodeServiceWatchInviteCodesServer) Send(m *WatchInviteCodesRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _UserInviteCodeService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VersionReq)
 	if err := dec(in); err != nil {
@@ -4029,6 +6467,299 @@ var _UserInviteCodeService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _UserInviteCodeService_ListFilteredInviteCodes_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchInviteCodes",
+			Handler:       _UserInviteCodeService_WatchInviteCodes_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rf_example.proto",
+}
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	SeedFixtures(ctx context.Context, in *SeedFixturesReq, opts ...grpc.CallOption) (*SeedFixturesRes, error)
+	ToggleLatency(ctx context.Context, in *ToggleLatencyReq, opts ...grpc.CallOption) (*ToggleLatencyRes, error)
+	ReloadConfig(ctx context.Context, in *ReloadConfigReq, opts ...grpc.CallOption) (*ReloadConfigRes, error)
+	Drain(ctx context.Context, in *DrainReq, opts ...grpc.CallOption) (*DrainRes, error)
+	GetRuntimeState(ctx context.Context, in *RuntimeStateReq, opts ...grpc.CallOption) (*RuntimeStateRes, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesReq, opts ...grpc.CallOption) (*ListWebhookDeliveriesRes, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsReq, opts ...grpc.CallOption) (*ListAuditEventsRes, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) SeedFixtures(ctx context.Context, in *SeedFixturesReq, opts ...grpc.CallOption) (*SeedFixturesRes, error) {
+	out := new(SeedFixturesRes)
+	err := c.cc.Invoke(ctx, "/aribor.AdminService/SeedFixtures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ToggleLatency(ctx context.Context, in *ToggleLatencyReq, opts ...grpc.CallOption) (*ToggleLatencyRes, error) {
+	out := new(ToggleLatencyRes)
+	err := c.cc.Invoke(ctx, "/aribor.AdminService/ToggleLatency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReloadConfig(ctx context.Context, in *ReloadConfigReq, opts ...grpc.CallOption) (*ReloadConfigRes, error) {
+	out := new(ReloadConfigRes)
+	err := c.cc.Invoke(ctx, "/aribor.AdminService/ReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Drain(ctx context.Context, in *DrainReq, opts ...grpc.CallOption) (*DrainRes, error) {
+	out := new(DrainRes)
+	err := c.cc.Invoke(ctx, "/aribor.AdminService/Drain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetRuntimeState(ctx context.Context, in *RuntimeStateReq, opts ...grpc.CallOption) (*RuntimeStateRes, error) {
+	out := new(RuntimeStateRes)
+	err := c.cc.Invoke(ctx, "/aribor.AdminService/GetRuntimeState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesReq, opts ...grpc.CallOption) (*ListWebhookDeliveriesRes, error) {
+	out := new(ListWebhookDeliveriesRes)
+	err := c.cc.Invoke(ctx, "/aribor.AdminService/ListWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsReq, opts ...grpc.CallOption) (*ListAuditEventsRes, error) {
+	out := new(ListAuditEventsRes)
+	err := c.cc.Invoke(ctx, "/aribor.AdminService/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+type AdminServiceServer interface {
+	SeedFixtures(context.Context, *SeedFixturesReq) (*SeedFixturesRes, error)
+	ToggleLatency(context.Context, *ToggleLatencyReq) (*ToggleLatencyRes, error)
+	ReloadConfig(context.Context, *ReloadConfigReq) (*ReloadConfigRes, error)
+	Drain(context.Context, *DrainReq) (*DrainRes, error)
+	GetRuntimeState(context.Context, *RuntimeStateReq) (*RuntimeStateRes, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesReq) (*ListWebhookDeliveriesRes, error)
+	ListAuditEvents(context.Context, *ListAuditEventsReq) (*ListAuditEventsRes, error)
+}
+
+// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (*UnimplementedAdminServiceServer) SeedFixtures(context.Context, *SeedFixturesReq) (*SeedFixturesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeedFixtures not implemented")
+}
+func (*UnimplementedAdminServiceServer) ToggleLatency(context.Context, *ToggleLatencyReq) (*ToggleLatencyRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleLatency not implemented")
+}
+func (*UnimplementedAdminServiceServer) ReloadConfig(context.Context, *ReloadConfigReq) (*ReloadConfigRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (*UnimplementedAdminServiceServer) Drain(context.Context, *DrainReq) (*DrainRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetRuntimeState(context.Context, *RuntimeStateReq) (*RuntimeStateRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuntimeState not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesReq) (*ListWebhookDeliveriesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListAuditEvents(context.Context, *ListAuditEventsReq) (*ListAuditEventsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
+}
+
+func _AdminService_SeedFixtures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeedFixturesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SeedFixtures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aribor.AdminService/SeedFixtures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SeedFixtures(ctx, req.(*SeedFixturesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ToggleLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToggleLatencyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ToggleLatency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aribor.AdminService/ToggleLatency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ToggleLatency(ctx, req.(*ToggleLatencyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aribor.AdminService/ReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReloadConfig(ctx, req.(*ReloadConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aribor.AdminService/Drain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Drain(ctx, req.(*DrainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetRuntimeState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuntimeStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetRuntimeState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aribor.AdminService/GetRuntimeState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetRuntimeState(ctx, req.(*RuntimeStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aribor.AdminService/ListWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/aribor.AdminService/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "aribor.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SeedFixtures",
+			Handler:    _AdminService_SeedFixtures_Handler,
+		},
+		{
+			MethodName: "ToggleLatency",
+			Handler:    _AdminService_ToggleLatency_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _AdminService_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _AdminService_Drain_Handler,
+		},
+		{
+			MethodName: "GetRuntimeState",
+			Handler:    _AdminService_GetRuntimeState_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _AdminService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _AdminService_ListAuditEvents_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "rf_example.proto",
 }
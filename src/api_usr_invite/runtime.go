@@ -0,0 +1,392 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	rfpbh "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	metaDrainTimeoutDefault = 5 * time.Second
+	metaDrainPollInterval   = 50 * time.Millisecond
+)
+
+// serviceRuntime keeps track of the operational state of this service instance, all ICP signal
+// handlers and the AdminService share the runtime* methods below (same code path for both).
+type serviceRuntime struct {
+	startedAt time.Time
+	draining  int32
+	inflight  int64
+	seedMu    sync.Mutex
+	lastSeed  *fixtureSeedReport
+
+	mongoDbMu        sync.RWMutex
+	mongoDbConnected bool
+	mongoDbChangedAt time.Time
+	migrationErr     error
+
+	// lifecycleMu guards the drain/shutdown channels and the cancel funcs of running database operations
+	lifecycleMu  sync.RWMutex
+	drain        chan struct{}
+	shutdown     chan struct{}
+	operationSeq uint64
+	operations   map[uint64]context.CancelFunc
+}
+
+var metaRuntime = &serviceRuntime{
+	startedAt:  time.Now(),
+	drain:      make(chan struct{}),
+	shutdown:   make(chan struct{}),
+	operations: map[uint64]context.CancelFunc{},
+}
+
+//
+// -- gRPC Runtime Stack 6/n :: shared runtime operations (signals && AdminService)
+//
+
+// runtimeSeedFixtures seeds the named fixture profile, an empty name seeds the active (default) profile.
+func runtimeSeedFixtures(profileName string) (*fixtureSeedReport, error) {
+
+	profile, err := metaFixtureProfiles.get(profileName)
+	if err != nil {
+		log.Warnf("%s: fixture seeding skipped: %v",metaServiceName,err)
+		return nil, err
+	}
+
+	report, err := mongoDbFixtureSeed(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	metaRuntime.seedMu.Lock()
+	metaRuntime.lastSeed = report
+	metaRuntime.seedMu.Unlock()
+
+	return report, nil
+}
+
+func runtimeToggleFaults() (faultInjectorState, error) {
+
+	enabled, err := metaFaults.toggle()
+	if err != nil {
+		log.Warnf("%s: fault rules reload failed, keep previous rules: %v",metaServiceName,err)
+	}
+
+	state := metaFaults.state()
+	log.Infof("%s: fault injection enabled=%v (rules: %d) ...",metaServiceName,enabled,len(state.Rules))
+
+	return state, err
+}
+
+// runtimeReloadConfig re-reads the configuration, it replaces the current one once all steps succeeded. The keys of
+// changed settings which are only read on startup (reload:"restart") are returned, they take effect after a restart.
+func runtimeReloadConfig() ([]string, error) {
+
+	current := configCurrent()
+	cfg, err := current.reload()
+	if err != nil {
+		log.Warnf("%s: config reload failed, keep previous config: %v",metaServiceName,err)
+		return nil, err
+	}
+
+	if err := runtimeRotateSecrets(cfg); err != nil {
+		log.Warnf("%s: secret rotation failed, keep previous secrets: %v",metaServiceName,err)
+		return nil, err
+	}
+
+	if err := metaFaults.reload(); err != nil {
+		log.Warnf("%s: fault rules reload failed, keep previous rules: %v",metaServiceName,err)
+		return nil, err
+	}
+
+	if err := metaFixtureProfiles.reload(); err != nil {
+		log.Warnf("%s: fixture profiles reload failed, keep previous profiles: %v",metaServiceName,err)
+		return nil, err
+	}
+
+	metaConfig.Store(cfg)
+	log.SetLevel(cfg.logLevel())
+	log.Infof("%s: configuration reloaded, log level set to [%s]",metaServiceName,log.GetLevel())
+
+	restart := current.restartChanges(cfg)
+	if len(restart) > 0 {
+		log.Warnf("%s: changed settings %v take effect after a restart",metaServiceName,restart)
+	}
+
+	return restart, nil
+}
+
+// runtimeRotateSecrets takes over rotated secrets (re-read from *_FILE mounts or the secret store), a
+// rotated mongodb credential re-opens the connection before the new secrets become active.
+func runtimeRotateSecrets(cfg *Config) error {
+
+	if metaSecrets.isMongoDbRotated(cfg) && mongoDbCurrent() != nil {
+		if err := mongoDbReconnect(cfg); err != nil {
+			return fmt.Errorf("mongodb reconnect failed: %v", err)
+		}
+	}
+
+	rotated, err := metaSecrets.apply(cfg)
+	if err != nil {
+		return err
+	}
+
+	if len(rotated) > 0 {
+		log.Infof("%s: rotated secrets %v",metaServiceName,rotated)
+	}
+
+	return nil
+}
+
+// runtimeDrain switches the service into draining mode (health NOT_SERVING, new calls are rejected)
+// and waits up to the given timeout for all in-flight calls, the number of remaining calls is returned.
+func runtimeDrain(timeout time.Duration) int64 {
+
+	metaRuntime.lifecycleMu.Lock()
+	if atomic.CompareAndSwapInt32(&metaRuntime.draining, 0, 1) {
+		log.Infof("%s: draining started (in-flight calls: %d)",metaServiceName,atomic.LoadInt64(&metaRuntime.inflight))
+		close(metaRuntime.drain)
+	}
+	metaRuntime.lifecycleMu.Unlock()
+
+	deadline := time.Now().Add(timeout)
+	for atomic.LoadInt64(&metaRuntime.inflight) > 0 && time.Now().Before(deadline) {
+		time.Sleep(metaDrainPollInterval)
+	}
+
+	remaining := atomic.LoadInt64(&metaRuntime.inflight)
+	if remaining > 0 {
+		log.Warnf("%s: draining timed out after %v (in-flight calls: %d)",metaServiceName,timeout,remaining)
+	}
+
+	return remaining
+}
+
+// runtimeShutdown cancels all database operations still running after draining
+func runtimeShutdown() {
+
+	metaRuntime.lifecycleMu.Lock()
+	defer metaRuntime.lifecycleMu.Unlock()
+
+	if _isRuntimeChannelClosed(metaRuntime.shutdown) {
+		return
+	}
+
+	log.Infof("%s: cancel remaining operations (in-flight calls: %d, database operations: %d)",metaServiceName,
+		atomic.LoadInt64(&metaRuntime.inflight),len(metaRuntime.operations))
+	close(metaRuntime.shutdown)
+	for id, cancel := range metaRuntime.operations {
+		cancel()
+		delete(metaRuntime.operations, id)
+	}
+}
+
+// runtimeTrackOperation registers the cancel func of a database operation until the returned func is called, all
+// registered operations are cancelled on shutdown, operations started after shutdown are cancelled at once.
+func runtimeTrackOperation(cancel context.CancelFunc) func() {
+
+	runtime := metaRuntime
+	runtime.lifecycleMu.Lock()
+	defer runtime.lifecycleMu.Unlock()
+
+	if _isRuntimeChannelClosed(runtime.shutdown) {
+		cancel()
+		return func() {}
+	}
+
+	runtime.operationSeq++
+	id := runtime.operationSeq
+	runtime.operations[id] = cancel
+
+	return func() {
+		runtime.lifecycleMu.Lock()
+		delete(runtime.operations, id)
+		runtime.lifecycleMu.Unlock()
+	}
+}
+
+func runtimeIsShuttingDown() bool {
+
+	metaRuntime.lifecycleMu.RLock()
+	defer metaRuntime.lifecycleMu.RUnlock()
+
+	return _isRuntimeChannelClosed(metaRuntime.shutdown)
+}
+
+func runtimeIsDraining() bool {
+	return atomic.LoadInt32(&metaRuntime.draining) == 1
+}
+
+// runtimeDrainStarted is closed once draining starts, long-lived streams (e.g. WatchInviteCodes) end then
+func runtimeDrainStarted() <-chan struct{} {
+
+	metaRuntime.lifecycleMu.RLock()
+	defer metaRuntime.lifecycleMu.RUnlock()
+
+	return metaRuntime.drain
+}
+
+// runtimeShutdownStarted is closed once the remaining operations are cancelled, background workers stop then
+func runtimeShutdownStarted() <-chan struct{} {
+
+	metaRuntime.lifecycleMu.RLock()
+	defer metaRuntime.lifecycleMu.RUnlock()
+
+	return metaRuntime.shutdown
+}
+
+// runtimeSetMongoDbConnected tracks the mongodb connectivity (startup ping and monitor), the service
+// reports NOT_SERVING while mongodb is unreachable, changes are logged and exported as metric.
+func runtimeSetMongoDbConnected(connected bool) {
+
+	metaRuntime.mongoDbMu.Lock()
+	changed := metaRuntime.mongoDbConnected != connected || metaRuntime.mongoDbChangedAt.IsZero()
+	if changed {
+		metaRuntime.mongoDbConnected, metaRuntime.mongoDbChangedAt = connected, time.Now()
+	}
+	metaRuntime.mongoDbMu.Unlock()
+
+	if !changed {
+		return
+	}
+
+	_recordMongoDbConnected(connected)
+	if connected {
+		log.Infof("%s: mongodb: connectivity established",metaServiceName)
+	} else {
+		log.Warnf("%s: mongodb: connectivity lost, health set to NOT_SERVING",metaServiceName)
+	}
+}
+
+func runtimeMongoDbState() (bool, time.Time) {
+
+	metaRuntime.mongoDbMu.RLock()
+	defer metaRuntime.mongoDbMu.RUnlock()
+
+	return metaRuntime.mongoDbConnected, metaRuntime.mongoDbChangedAt
+}
+
+// runtimeSetMigrationError tracks the result of the startup migrations, the service reports NOT_SERVING
+// while the schema is not up to date (e.g. the unique meta_code index is missing), nil clears the error.
+func runtimeSetMigrationError(err error) {
+
+	metaRuntime.mongoDbMu.Lock()
+	recovered := metaRuntime.migrationErr != nil && err == nil
+	metaRuntime.migrationErr = err
+	metaRuntime.mongoDbMu.Unlock()
+
+	if err != nil {
+		log.Errorf("%s: mongodb: %v, health set to NOT_SERVING until the migrations succeed",metaServiceName,err)
+	} else if recovered {
+		log.Infof("%s: mongodb: migrations applied, health restored",metaServiceName)
+	}
+}
+
+func runtimeMigrationError() error {
+
+	metaRuntime.mongoDbMu.RLock()
+	defer metaRuntime.mongoDbMu.RUnlock()
+
+	return metaRuntime.migrationErr
+}
+
+func runtimeHealthStatus() rfpbh.HealthCheckResponse_ServingStatus {
+
+	if connected, _ := runtimeMongoDbState(); !connected || runtimeMigrationError() != nil || runtimeIsDraining() {
+		return rfpbh.HealthCheckResponse_NOT_SERVING
+	}
+
+	return rfpbh.HealthCheckResponse_SERVING
+}
+
+func runtimeLastSeed() *fixtureSeedReport {
+
+	metaRuntime.seedMu.Lock()
+	defer metaRuntime.seedMu.Unlock()
+
+	return metaRuntime.lastSeed
+}
+
+func runtimeUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	if _isServiceInternalMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	if err := _runtimeEnterCall(); err != nil {
+		return nil, err
+	};  defer atomic.AddInt64(&metaRuntime.inflight, -1)
+
+	return handler(ctx, req)
+}
+
+func runtimeStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+	if _isServiceInternalMethod(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	if err := _runtimeEnterCall(); err != nil {
+		return err
+	};  defer atomic.AddInt64(&metaRuntime.inflight, -1)
+
+	return handler(srv, ss)
+}
+
+//
+// -- sidekick stack for runtime helper methods
+//
+
+func _isRuntimeChannelClosed(ch chan struct{}) bool {
+
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func _runtimeEnterCall() error {
+
+	atomic.AddInt64(&metaRuntime.inflight, 1)
+	if runtimeIsDraining() {
+		atomic.AddInt64(&metaRuntime.inflight, -1)
+		return status.Errorf(codes.Unavailable, "%s is draining, please retry on another instance", metaServiceName)
+	}
+
+	return nil
+}
+
+// _isServiceInternalMethod reports calls of the health, reflection and admin services which are
+// neither counted as in-flight calls nor affected by draining or fault injection.
+func _isServiceInternalMethod(fullMethod string) bool {
+
+	return strings.HasPrefix(fullMethod, "/grpc.health.v1.Health/") ||
+		strings.HasPrefix(fullMethod, "/grpc.reflection.") ||
+		strings.HasPrefix(fullMethod, "/aribor.AdminService/")
+}
@@ -0,0 +1,368 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	metaSecretRefPrefix          = "secret://"
+	metaSecretProviderFile       = "encrypted-file"
+	metaSecretStoreVersion       = 1
+	metaSecretStoreKeySize       = 32
+	metaSecretSigningKeyMinBytes = 32
+)
+
+// secretProvider resolves secret references (secret://<name>) used as config values, providers are
+// created from the configuration on every (re)load, so rotated secrets are picked up on SIGHUP.
+type secretProvider interface {
+	GetSecret(name string) (string, error)
+}
+
+type secretProviderFactory func(cfg *Config) (secretProvider, error)
+
+// encryptedFileSecretStore is a local secret store, a JSON map of secrets sealed by AES-256-GCM
+type encryptedFileSecretStore struct {
+	file    string
+	secrets map[string]string
+}
+
+type encryptedFileSecretStoreEnvelope struct {
+	Version int    `json:"version"`
+	Nonce   string `json:"nonce"`
+	Data    string `json:"data"`
+}
+
+// serviceSecrets holds all secrets which can be rotated at runtime (SIGHUP), consumers always read
+// the current value through the getters below instead of keeping a copy.
+type serviceSecrets struct {
+	mu         sync.RWMutex
+	adminToken string
+	signingKey string
+	tlsCert    *tls.Certificate
+	mongoDb    [3]string
+}
+
+var (
+	secretProviderMu sync.RWMutex
+	secretProviders  = map[string]secretProviderFactory{
+		metaSecretProviderFile: newEncryptedFileSecretStoreFromConfig,
+	}
+)
+
+var metaSecrets = &serviceSecrets{}
+
+//
+// -- gRPC Secret Stack 10/n :: secret providers && rotation
+//
+
+// registerSecretProvider makes a secret provider available for SECRET_PROVIDER, e.g. a vault client
+func registerSecretProvider(name string, factory secretProviderFactory) {
+
+	secretProviderMu.Lock()
+	secretProviders[name] = factory
+	secretProviderMu.Unlock()
+}
+
+func newSecretProvider(cfg *Config) (secretProvider, error) {
+
+	secretProviderMu.RLock()
+	factory, ok := secretProviders[cfg.SecretProvider]
+	secretProviderMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown secret provider [%s]", cfg.SecretProvider)
+	}
+
+	return factory(cfg)
+}
+
+func newEncryptedFileSecretStoreFromConfig(cfg *Config) (secretProvider, error) {
+
+	if cfg.SecretStoreFile == "" || cfg.SecretStoreKey == "" {
+		return nil, errors.New("secret store requires SECRET_STORE_FILE and SECRET_STORE_KEY")
+	}
+
+	key, err := _getSecretStoreKey(cfg.SecretStoreKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return openEncryptedFileSecretStore(cfg.SecretStoreFile, key)
+}
+
+func openEncryptedFileSecretStore(file string, key []byte) (*encryptedFileSecretStore, error) {
+
+	raw, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read secret store [%s]: %v", file, err)
+	}
+
+	envelope := &encryptedFileSecretStoreEnvelope{}
+	if err := json.Unmarshal(raw, envelope); err != nil {
+		return nil, fmt.Errorf("unable to parse secret store [%s]: %v", file, err)
+	}
+
+	if envelope.Version != metaSecretStoreVersion {
+		return nil, fmt.Errorf("unsupported secret store version [%d] in [%s]", envelope.Version, file)
+	}
+
+	plain, err := _openSecretStoreEnvelope(envelope, key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt secret store [%s]: %v", file, err)
+	}
+
+	s := &encryptedFileSecretStore{file: file}
+	if err := json.Unmarshal(plain, &s.secrets); err != nil {
+		return nil, fmt.Errorf("unable to parse secrets of store [%s]: %v", file, err)
+	}
+
+	return s, nil
+}
+
+// sealEncryptedFileSecretStore encrypts the given secrets, the result can be written to SECRET_STORE_FILE
+func sealEncryptedFileSecretStore(secrets map[string]string, key []byte) ([]byte, error) {
+
+	plain, err := json.Marshal(secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := _getSecretStoreAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(&encryptedFileSecretStoreEnvelope{
+		Version: metaSecretStoreVersion,
+		Nonce:   base64.StdEncoding.EncodeToString(nonce),
+		Data:    base64.StdEncoding.EncodeToString(aead.Seal(nil, nonce, plain, nil)),
+	}, "", "  ")
+}
+
+// sealSecretStoreFile encrypts the plain JSON secret map given by --seal-secret-store to w
+func sealSecretStoreFile(cfg *Config, w io.Writer) error {
+
+	key, err := _getSecretStoreKey(cfg.SecretStoreKey)
+	if err != nil {
+		return err
+	}
+
+	raw, err := ioutil.ReadFile(cfg.SealSecretStore)
+	if err != nil {
+		return fmt.Errorf("unable to read secrets [%s]: %v", cfg.SealSecretStore, err)
+	}
+
+	secrets := map[string]string{}
+	if err := json.Unmarshal(raw, &secrets); err != nil {
+		return fmt.Errorf("unable to parse secrets [%s]: %v", cfg.SealSecretStore, err)
+	}
+
+	sealed, err := sealEncryptedFileSecretStore(secrets, key)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s\n", sealed)
+
+	return err
+}
+
+func (s *encryptedFileSecretStore) GetSecret(name string) (string, error) {
+
+	value, ok := s.secrets[name]
+	if !ok {
+		return "", fmt.Errorf("secret [%s] not found in secret store [%s]", name, s.file)
+	}
+
+	return value, nil
+}
+
+// apply takes over all secrets of the given configuration and returns the names of all rotated ones
+func (s *serviceSecrets) apply(cfg *Config) ([]string, error) {
+
+	var cert *tls.Certificate
+	if cfg.TLSCert != "" {
+		pair, err := tls.X509KeyPair([]byte(cfg.TLSCert), []byte(cfg.TLSKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid TLS key pair: %v", err)
+		}
+		cert = &pair
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var rotated []string
+	if s.adminToken != cfg.AdminToken {
+		rotated = append(rotated, "ADMIN_TOKEN")
+	}
+	if s.signingKey != cfg.SigningKey {
+		rotated = append(rotated, "SIGNING_KEY")
+	}
+	if (s.tlsCert == nil) != (cert == nil) || (cert != nil && string(s.tlsCert.Certificate[0]) != string(cert.Certificate[0])) {
+		rotated = append(rotated, "TLS_CERT")
+	}
+	if mongoDb := [3]string{cfg.MongoDbUsr, cfg.MongoDbPwd, cfg.MongoDbLnk}; s.mongoDb != mongoDb {
+		rotated = append(rotated, "DB_MONGO")
+		s.mongoDb = mongoDb
+	}
+
+	s.adminToken, s.signingKey, s.tlsCert = cfg.AdminToken, cfg.SigningKey, cert
+	sort.Strings(rotated)
+
+	return rotated, nil
+}
+
+// isMongoDbRotated reports changed mongodb credentials compared to the active ones
+func (s *serviceSecrets) isMongoDbRotated(cfg *Config) bool {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.mongoDb != [3]string{cfg.MongoDbUsr, cfg.MongoDbPwd, cfg.MongoDbLnk}
+}
+
+func (s *serviceSecrets) getAdminToken() string {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.adminToken
+}
+
+func (s *serviceSecrets) getSigningKey() string {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.signingKey
+}
+
+func (s *serviceSecrets) hasTLS() bool {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.tlsCert != nil
+}
+
+// getCertificate serves the current certificate, a rotated certificate is used for new handshakes
+func (s *serviceSecrets) getCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.tlsCert == nil {
+		return nil, errors.New("no TLS certificate configured")
+	}
+
+	return s.tlsCert, nil
+}
+
+func (s *serviceSecrets) tlsConfig() *tls.Config {
+
+	if !s.hasTLS() {
+		return nil
+	}
+
+	return &tls.Config{GetCertificate: s.getCertificate, MinVersion: tls.VersionTLS12, NextProtos: []string{"h2", "http/1.1"}}
+}
+
+//
+// -- sidekick stack for secret helper methods
+//
+
+// _resolveConfigSecretRef resolves a secret://<name> config value, all other values are returned as is
+func _resolveConfigSecretRef(value string, provider func() (secretProvider, error)) (string, bool, error) {
+
+	if !strings.HasPrefix(value, metaSecretRefPrefix) {
+		return value, false, nil
+	}
+
+	p, err := provider()
+	if err != nil {
+		return "", true, err
+	}
+
+	secret, err := p.GetSecret(strings.TrimPrefix(value, metaSecretRefPrefix))
+
+	return secret, true, err
+}
+
+func _getSecretStoreKey(encoded string) ([]byte, error) {
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil || len(key) != metaSecretStoreKeySize {
+		return nil, fmt.Errorf("SECRET_STORE_KEY must be a base64 encoded %d byte key", metaSecretStoreKeySize)
+	}
+
+	return key, nil
+}
+
+func _getSecretStoreAEAD(key []byte) (cipher.AEAD, error) {
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func _openSecretStoreEnvelope(envelope *encryptedFileSecretStoreEnvelope, key []byte) ([]byte, error) {
+
+	nonce, err := base64.StdEncoding.DecodeString(envelope.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(envelope.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := _getSecretStoreAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nonce) != aead.NonceSize() {
+		return nil, errors.New("invalid nonce size")
+	}
+
+	return aead.Open(nil, nonce, data, nil)
+}
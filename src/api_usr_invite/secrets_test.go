@@ -0,0 +1,208 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+const secretTestEnvFile = `
+DB_MONGO_USR=usr
+DB_MONGO_PDB=db
+DB_MONGO_LNK=mongodb://localhost:27017/
+`
+
+var secretTestKey = bytes.Repeat([]byte{0x2a}, metaSecretStoreKeySize)
+
+//
+// -- core test helper methods :: *.n
+//
+
+func secretTestStoreFile(t *testing.T, secrets map[string]string) string {
+
+	sealed, err := sealEncryptedFileSecretStore(secrets, secretTestKey)
+	if err != nil { t.Fatal(err) }
+
+	return configTestEnvFilePath(t, string(sealed))
+}
+
+// secretTestKeyPair generates a self-signed PEM encoded certificate and key
+func secretTestKeyPair(t *testing.T) (string, string) {
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil { t.Fatal(err) }
+
+	tpl := &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "localhost"},
+		NotBefore: time.Now(), NotAfter: time.Now().Add(time.Hour), DNSNames: []string{"localhost"}}
+
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	if err != nil { t.Fatal(err) }
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil { t.Fatal(err) }
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+//
+// -- core test methods :: secrets
+//
+
+func TestSecretStore_SealAndOpen(t *testing.T) {
+
+	file := secretTestStoreFile(t, map[string]string{"mongo-pwd": "s3cr3t"})
+	defer os.Remove(file)
+
+	raw, _ := ioutil.ReadFile(file)
+	assert.NotContains(t, string(raw), "s3cr3t")
+
+	store, err := openEncryptedFileSecretStore(file, secretTestKey)
+	if err != nil { t.Fatal(err) }
+
+	secret, err := store.GetSecret("mongo-pwd")
+	assert.NoError(t, err)
+	assert.Equal(t, "s3cr3t", secret)
+
+	_, err = store.GetSecret("unknown")
+	assert.Error(t, err)
+
+	_, err = openEncryptedFileSecretStore(file, bytes.Repeat([]byte{0x01}, metaSecretStoreKeySize))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to decrypt secret store")
+}
+
+func TestSecretStore_SealFile(t *testing.T) {
+
+	plain := configTestEnvFilePath(t, `{"admin-token":"token"}`)
+	defer os.Remove(plain)
+
+	out := &bytes.Buffer{}
+	err := sealSecretStoreFile(&Config{SealSecretStore: plain, SecretStoreKey: base64.StdEncoding.EncodeToString(secretTestKey)}, out)
+	if err != nil { t.Fatal(err) }
+
+	sealed := configTestEnvFilePath(t, out.String())
+	defer os.Remove(sealed)
+
+	store, err := openEncryptedFileSecretStore(sealed, secretTestKey)
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, "token", store.secrets["admin-token"])
+
+	err = sealSecretStoreFile(&Config{SealSecretStore: plain, SecretStoreKey: "too-short"}, out)
+	assert.Error(t, err)
+}
+
+func TestSecretConfig_FromFile(t *testing.T) {
+
+	envFile := configTestEnvFilePath(t, secretTestEnvFile)
+	pwdFile := configTestEnvFilePath(t, "file-pwd\n")
+	defer os.Remove(envFile)
+	defer os.Remove(pwdFile)
+	defer configTestSetEnv(map[string]string{"DB_MONGO_PWD": "", "DB_MONGO_PWD_FILE": pwdFile, "PORT": "", "ADMIN_PORT": ""})()
+
+	cfg, err := loadConfig([]string{"--env-file", envFile})
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, "file-pwd", cfg.MongoDbPwd)
+	assert.Equal(t, configSourceEnv+" file", cfg.sources["DB_MONGO_PWD"])
+
+	// KEY and KEY_FILE on the same layer are ambiguous
+	_ = os.Setenv("DB_MONGO_PWD", "env-pwd")
+	_, err = loadConfig([]string{"--env-file", envFile})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "DB_MONGO_PWD and DB_MONGO_PWD_FILE are mutually exclusive")
+}
+
+func TestSecretConfig_SecretRef(t *testing.T) {
+
+	store := secretTestStoreFile(t, map[string]string{"mongo-pwd": "store-pwd"})
+	envFile := configTestEnvFilePath(t, secretTestEnvFile+"DB_MONGO_PWD=secret://mongo-pwd\nADMIN_TOKEN=secret://unknown\n")
+	defer os.Remove(store)
+	defer os.Remove(envFile)
+	defer configTestSetEnv(map[string]string{
+		"DB_MONGO_PWD": "", "ADMIN_TOKEN": "", "PORT": "", "ADMIN_PORT": "",
+		"SECRET_STORE_FILE": store, "SECRET_STORE_KEY": base64.StdEncoding.EncodeToString(secretTestKey),
+	})()
+
+	cfg, err := loadConfig([]string{"--env-file", envFile})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "ADMIN_TOKEN: secret [unknown] not found")
+	assert.Equal(t, "store-pwd", cfg.MongoDbPwd)
+	assert.Equal(t, configSourceEnvFile+" ("+metaSecretProviderFile+")", cfg.sources["DB_MONGO_PWD"])
+
+	out := &bytes.Buffer{}
+	cfg.print(out)
+	assert.NotContains(t, out.String(), "store-pwd")
+}
+
+func TestSecretConfig_Validation(t *testing.T) {
+
+	cert, key := secretTestKeyPair(t)
+	envFile := configTestEnvFilePath(t, secretTestEnvFile+"DB_MONGO_PWD=pwd\nSIGNING_KEY=short\n")
+	certFile := configTestEnvFilePath(t, cert)
+	defer os.Remove(envFile)
+	defer os.Remove(certFile)
+	defer configTestSetEnv(map[string]string{"DB_MONGO_PWD": "", "SIGNING_KEY": "", "TLS_KEY": "", "TLS_CERT_FILE": certFile, "PORT": "", "ADMIN_PORT": ""})()
+
+	_, err := loadConfig([]string{"--env-file", envFile})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "SIGNING_KEY: must have at least 32 bytes")
+	assert.Contains(t, err.Error(), "TLS_CERT/TLS_KEY: both or none must be set")
+
+	_, err = loadConfig([]string{"--env-file", envFile, "--tls-key", key, "--signing-key", strings.Repeat("k", 32)})
+	assert.NoError(t, err)
+}
+
+func TestSecrets_Rotation(t *testing.T) {
+
+	cert, key := secretTestKeyPair(t)
+	secrets := &serviceSecrets{}
+
+	rotated, err := secrets.apply(&Config{AdminToken: "a", MongoDbUsr: "usr", TLSCert: cert, TLSKey: key})
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, []string{"ADMIN_TOKEN", "DB_MONGO", "TLS_CERT"}, rotated)
+	assert.True(t, secrets.hasTLS())
+	assert.NotNil(t, secrets.tlsConfig())
+
+	// consumers see the rotated value on their next read
+	rotated, err = secrets.apply(&Config{AdminToken: "b", MongoDbUsr: "usr", TLSCert: cert, TLSKey: key})
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, []string{"ADMIN_TOKEN"}, rotated)
+	assert.Equal(t, "b", secrets.getAdminToken())
+	assert.False(t, secrets.isMongoDbRotated(&Config{MongoDbUsr: "usr"}))
+	assert.True(t, secrets.isMongoDbRotated(&Config{MongoDbUsr: "usr", MongoDbPwd: "rotated"}))
+
+	// an invalid key pair keeps all current secrets
+	_, err = secrets.apply(&Config{AdminToken: "c", TLSCert: cert, TLSKey: "invalid"})
+	assert.Error(t, err)
+	assert.Equal(t, "b", secrets.getAdminToken())
+	assert.True(t, secrets.hasTLS())
+}
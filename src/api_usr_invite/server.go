@@ -22,25 +22,26 @@ import (
 	"context"
 	"fmt"
 	rftlp "github.com/RelicFrog/go-lib-pub-tlp"
-	"github.com/golang/protobuf/ptypes"
-	"github.com/joho/godotenv"
-	"github.com/oklog/ulid/v2"
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
-	"go.mongodb.org/mongo-driver/x/bsonx"
 	"go.opencensus.io/plugin/ocgrpc"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	rfpbh "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
-	"math/rand"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 )
@@ -49,19 +50,18 @@ const (
 	metaServiceVersion       = "1.0.0"
 	metaServiceName          = "grpc_usr_invite"
 	metaMongoDbCollectionTbl = "user_codes"
+	metaListTrailerCount     = "x-result-count"
+	metaListTrailerTruncated = "x-result-truncated"
+	metaListTrailerPartial   = "x-result-partial"
 )
 
 var (
 	log *logrus.Logger
-	metaMongoDbUsr string
-	metaMongoDbPwd string
-	metaMongoDbLnk string
-	metaMongoDbPDB string
-    metaMongoDbClient *mongo.Client
-	metaMongoDbCollection *mongo.Collection
 	metaMongoDbContext = context.Background()
-	metaServicePort string
-	extraLatency time.Duration
+	metaFaults *faultInjector
+	metaFixtureProfiles *fixtureProfileStore
+	metaWebhooks *webhookDispatcher
+	metaOutbox *outboxRelay
     err error
 )
 
@@ -76,6 +76,9 @@ type UserInviteCode struct {
 	IsFixture      bool               `bson:"is_fixture"`
 	IsDeleted      bool               `bson:"is_deleted"`
 	IsTest         bool               `bson:"is_test"`
+	DeletedAt      time.Time          `bson:"deleted_at,omitempty"`
+	UpdatedAt      time.Time          `bson:"updated_at,omitempty"`
+	Version        int64              `bson:"version"`
 }
 
 //
@@ -90,9 +93,6 @@ func init() {
 
 	log = logrus.New()
 	log.Level = logrus.DebugLevel
-	if metaDebugMode := _getDotEnvVariable("DISABLE_DEBUG"); metaDebugMode == "1" {
-		log.Level = logrus.ErrorLevel
-	}
 
 	log.Formatter = &logrus.JSONFormatter{
 		FieldMap: logrus.FieldMap{
@@ -102,54 +102,86 @@ func init() {
 		},
 		TimestampFormat: time.RFC3339Nano,
 	};  log.Out = os.Stdout
+}
 
-	//
-	// -- define config bound mechanics (using .env) --
-	//
+// configure applies the (validated) configuration, it has to be called before the service is started.
+func configure(cfg *Config) {
+
+	metaConfig.Store(cfg)
+	log.SetLevel(cfg.logLevel())
+
+	if _, err := metaSecrets.apply(cfg); err != nil {
+		log.Fatalf("%s: %v <exit>",metaServiceName,err)
+	}
 
-	if metaTracerDisabled := _getDotEnvVariable("DISABLE_TRACING"); metaTracerDisabled == "0" {
+	if !cfg.DisableTracing {
 		log.Infof("%s: tracing enabled.",metaServiceName)
-		go rftlp.InitTracing(metaServiceName, _getDotEnvVariable("JAEGER_SERVICE_ADDR"), log)
+		go rftlp.InitTracing(metaServiceName, cfg.JaegerServiceAddr, log)
 	}
 
-	if metaProfilerDisabled := _getDotEnvVariable("DISABLE_PROFILER"); metaProfilerDisabled == "0" {
+	if !cfg.DisableProfiler {
 		log.Infof("%s: profiling enabled.",metaServiceName)
 		go rftlp.InitProfiling(metaServiceName, metaServiceVersion, log)
 	}
 
-	if metaMongoDbUsr = _getDotEnvVariable("DB_MONGO_USR"); metaMongoDbUsr == "" {
-		log.Fatalf("%s: mongoDB-Service-User not set <exit>",metaServiceName)
-	}
-
-	if metaMongoDbPwd = _getDotEnvVariable("DB_MONGO_PWD"); metaMongoDbPwd == "" {
-		log.Fatalf("%s: mongoDB-Password not set <exit>",metaServiceName)
+	if metaFaults, err = newFaultInjector(cfg.FaultRulesFile); err != nil {
+		log.Fatalf("%s: %v <exit>",metaServiceName,err)
 	}
 
-	if metaMongoDbPDB = _getDotEnvVariable("DB_MONGO_PDB"); metaMongoDbPDB == "" {
-		log.Fatalf("%s: mongoDB primary service db not found <exit>",metaServiceName)
+	if metaFixtureProfiles, err = newFixtureProfileStore(cfg.FixtureProfilesFile, cfg.FixtureProfile); err != nil {
+		log.Fatalf("%s: %v <exit>",metaServiceName,err)
 	}
+	log.Infof("%s: fixture profiles %v loaded",metaServiceName,metaFixtureProfiles.names())
 
-	if metaMongoDbLnk = _getDotEnvVariable("DB_MONGO_LNK"); metaMongoDbLnk == "" {
-		log.Fatalf("%s: mongoDB connection link not found <exit>",metaServiceName)
+	if metaWebhooks, err = newWebhookDispatcher(cfg); err != nil {
+		log.Fatalf("%s: %v <exit>",metaServiceName,err)
 	}
 
-	if metaServicePort = _getDotEnvVariable("PORT"); metaServicePort == "" {
-		log.Fatalf("%s: service port definition missing <exit>",metaServiceName)
+	if metaOutbox, err = newOutboxRelay(cfg, metaWebhooks); err != nil {
+		log.Fatalf("%s: %v <exit>",metaServiceName,err)
 	}
 }
 
 func main() {
 
+	//
+	// -- define config bound mechanics (defaults, .env, env, flags) --
+	//
+
+	cfg, err := loadConfig(os.Args[1:])
+	if cfg != nil && cfg.SealSecretStore != "" {
+		if err := sealSecretStoreFile(cfg, os.Stdout); err != nil {
+			log.Fatalf("%s: %v <exit>",metaServiceName,err)
+		}
+		os.Exit(0)
+	}
+
+	if err != nil {
+		log.Fatalf("%s: %v <exit>",metaServiceName,err)
+	}
+
+	if cfg.PrintConfig {
+		cfg.print(os.Stdout)
+		os.Exit(0)
+	}
+
+	if cfg.Migrate != "" {
+		if err := migrateCommand(cfg, os.Stdout); err != nil {
+			log.Fatalf("%s: %v <exit>",metaServiceName,err)
+		}
+		os.Exit(0)
+	}
+
+	configure(cfg)
+
 	log.Infof("%s: start",metaServiceName)
 
 	//
 	// -- primary startup sequence --
 	//
 
-	mongoDbInitCon()
-
 	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs,syscall.SIGINT,syscall.SIGTERM,syscall.SIGABRT,syscall.SIGUSR1,syscall.SIGUSR2)
+	signal.Notify(sigs,syscall.SIGINT,syscall.SIGTERM,syscall.SIGABRT,syscall.SIGUSR1,syscall.SIGUSR2,syscall.SIGHUP)
 	go func() {
 
 		for {
@@ -158,6 +190,9 @@ func main() {
 			// handle service abort signals (SIGINT/SIGTERM/SIGABRT)
 			if sig == syscall.SIGINT ||  sig == syscall.SIGTERM ||  sig == syscall.SIGABRT  {
 				log.Infof("%s: handle QUIT signal [%s] ...",metaServiceName,sig.String())
+				runtimeDrain(configCurrent().DrainTimeout)
+				metaOutbox.drain(configCurrent().DrainTimeout)
+				runtimeShutdown()
 				log.Infof("%s: done",metaServiceName)
 
 				mongoDbCloseCon()
@@ -167,63 +202,129 @@ func main() {
 			// handle fixture load / db seeding signal (notify on syscall.USR1)
 			if sig == syscall.SIGUSR1 {
 				log.Infof("%s: handle seed database signal [%s] ...",metaServiceName,sig.String())
-				if err := mongoDbFixtureCreateIndexes(); err != nil { mongoDbFixtureHandleFatal(err) }
-				if err := mongoDbFixtureLoadInviteCodes(); err != nil { mongoDbFixtureHandleFatal(err) }
+				go func() { _, _ = runtimeSeedFixtures("") }()
 			}
 
-			// handle extra latency signal (notify on syscall.USR2)
+			// handle fault injection toggle signal (notify on syscall.USR2)
 			if sig == syscall.SIGUSR2 {
-				log.Infof("%s: handle extra latency signal [%s] ...",metaServiceName,sig.String())
-				// check inactive state (switch latency flag)
-				if  extraLatency == time.Duration(0) {
-					extraLatency = time.Duration(rand.Int63n(1750))*time.Millisecond
-					log.Infof("%s: extra latency enabled (duration: %v) ...",metaServiceName,extraLatency)
-				} else {
-					extraLatency = time.Duration(0)
-					log.Infof("%s: extra latency disabled (duration: %v) ...",metaServiceName,extraLatency)
-				}
+				log.Infof("%s: handle fault injection signal [%s] ...",metaServiceName,sig.String())
+				_, _ = runtimeToggleFaults()
 			}
 
 			// handle reload config signal (notify on syscall.SIGHUP)
-			// *** not implemented yet ***
+			if sig == syscall.SIGHUP {
+				log.Infof("%s: handle reload config signal [%s] ...",metaServiceName,sig.String())
+				_, _ = runtimeReloadConfig()
+			}
 		}
 	}()
 
-	log.Infof("%s: starting gRPC server at port [%s] ...",metaServiceName,metaServicePort)
-	run(metaServicePort); select {}
+	// the client exists before the first call is accepted, calls fail (instead of crashing) until mongodb is reachable
+	mongoDbConnect()
+
+	log.Infof("%s: starting gRPC server at port [%d] ...",metaServiceName,configCurrent().Port)
+	run(configCurrent().Port)
+
+	// health reports NOT_SERVING until mongodb is reachable (startup retry with backoff)
+	mongoDbInitCon(); select {}
 }
 
-func run(port string) string {
+func run(port int) string {
 
-	l, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil { log.Fatal(err) }
 
-	var srv *grpc.Server
-	if metaGRPCStatsDisabled := _getDotEnvVariable("DISABLE_STATS"); metaGRPCStatsDisabled == "0" {
-		log.Infof("%s: gRPC stats enabled.",metaServiceName)
-		srv = grpc.NewServer(grpc.StatsHandler(&ocgrpc.ServerHandler{}))
-	} else {
-		srv = grpc.NewServer()
+	srvOpts := serverOptions()
+
+	// TLS certificates are served from metaSecrets, a rotated certificate is used after SIGHUP
+	tlsConfig := metaSecrets.tlsConfig()
+	if tlsConfig != nil && configCurrent().DisableWeb {
+		srvOpts = append(srvOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	}
 
+	srv := grpc.NewServer(srvOpts...)
+
 	userInviteCodeSVC := &UserInviteCodeServiceServer{}
 	rfpb.RegisterUserInviteCodeServiceServer(srv, userInviteCodeSVC)
 	rfpbh.RegisterHealthServer(srv, userInviteCodeSVC)
 	reflection.Register(srv) // activate reflections for grpc
 
-	go srv.Serve(l)
+	// serve the AdminService on its own port, or on the primary port if (at least) a token is set
+	if configCurrent().AdminPort != 0 {
+		runAdmin(configCurrent().AdminPort)
+	} else if configCurrent().AdminToken != "" {
+		log.Infof("%s: admin service enabled on primary port (token protected).",metaServiceName)
+		rfpb.RegisterAdminServiceServer(srv, &AdminServiceServer{})
+	} else {
+		log.Infof("%s: admin service disabled (set ADMIN_PORT and/or ADMIN_TOKEN).",metaServiceName)
+	}
+
+	// serve gRPC, gRPC-Web and connect on the same (h2c) port, envoy becomes optional this way
+	if configCurrent().DisableWeb {
+		go srv.Serve(l)
+	} else {
+		web, err := newWebBridge(srv, configCurrent().WebCORSOrigin)
+		if err != nil { log.Fatal(err) }
+
+		log.Infof("%s: gRPC-Web/Connect bridge enabled (TLS: %v).",metaServiceName,tlsConfig != nil)
+		if tlsConfig != nil {
+			go (&http.Server{Handler: web, TLSConfig: tlsConfig}).ServeTLS(l, "", "")
+		} else {
+			go (&http.Server{Handler: h2c.NewHandler(web, &http2.Server{})}).Serve(l)
+		}
+	}
 
 	log.Infof("%s: send SIG.TERM or SIG.INT (CTRL+c) to quit this gRPC endpoint ...",metaServiceName)
 
 	return l.Addr().String()
 }
 
+// serverOptions returns the interceptor chains (and stats handler) of the primary port, the integration tests serve
+// the same options, so every call passes auth, audit, runtime, fault injection and validation.
+func serverOptions() []grpc.ServerOption {
+
+	srvOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(adminAuthInterceptor(metaSecrets.getAdminToken), auditUnaryInterceptor, runtimeUnaryInterceptor, metaFaults.unaryInterceptor, validationUnaryInterceptor),
+		grpc.ChainStreamInterceptor(runtimeStreamInterceptor, metaFaults.streamInterceptor, validationStreamInterceptor),
+	}
+
+	if !configCurrent().DisableStats {
+		log.Infof("%s: gRPC stats enabled.",metaServiceName)
+		if err := registerMetricViews(); err != nil { log.Warnf("%s: unable to register metric views: %v",metaServiceName,err) }
+		srvOpts = append(srvOpts, grpc.StatsHandler(&ocgrpc.ServerHandler{}))
+	}
+
+	return srvOpts
+}
+
+func runAdmin(port int) string {
+
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil { log.Fatal(err) }
+
+	srvOpts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(adminAuthInterceptor(metaSecrets.getAdminToken))}
+	if tlsConfig := metaSecrets.tlsConfig(); tlsConfig != nil {
+		srvOpts = append(srvOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	srv := grpc.NewServer(srvOpts...)
+	rfpb.RegisterAdminServiceServer(srv, &AdminServiceServer{})
+	rfpbh.RegisterHealthServer(srv, &UserInviteCodeServiceServer{})
+	reflection.Register(srv)
+
+	go srv.Serve(l)
+
+	log.Infof("%s: admin service started at port [%d] (token protected: %v) ...",metaServiceName,port,metaSecrets.getAdminToken() != "")
+
+	return l.Addr().String()
+}
+
 //
 // -- gRPC Method Stack 1/n :: HealthCheck(s)
 //
 
 func (u UserInviteCodeServiceServer) Check(_ context.Context, _ *rfpbh.HealthCheckRequest) (*rfpbh.HealthCheckResponse, error) {
-	return &rfpbh.HealthCheckResponse{Status: rfpbh.HealthCheckResponse_SERVING}, nil
+	return &rfpbh.HealthCheckResponse{Status: runtimeHealthStatus()}, nil
 }
 
 func (u UserInviteCodeServiceServer) Watch(_ *rfpbh.HealthCheckRequest, _ rfpbh.Health_WatchServer) error {
@@ -235,47 +336,45 @@ func (u UserInviteCodeServiceServer) Watch(_ *rfpbh.HealthCheckRequest, _ rfpbh.
 //
 
 func (u UserInviteCodeServiceServer) GetVersion(_ context.Context, _ *rfpb.VersionReq) (*rfpb.VersionRes, error) {
-
-	_handleSimLoadLatency()
 	return &rfpb.VersionRes{Version: fmt.Sprintf("v%s", metaServiceVersion)}, nil
 }
 
-func (u UserInviteCodeServiceServer) CreateInviteCode(_ context.Context, req *rfpb.CreateInviteCodeReq) (*rfpb.CreateInviteCodeRes, error) {
-
-	_handleSimLoadLatency()
+func (u UserInviteCodeServiceServer) CreateInviteCode(ctx context.Context, req *rfpb.CreateInviteCodeReq) (*rfpb.CreateInviteCodeRes, error) {
 
 	// essentially doing req.GetInviteCode to access the struct with a nil check
-	metaCode := req.GetInviteCode()
-	metaData := UserInviteCode{
-		MetaCode: metaCode.GetMetaCode(),
-		MetaForAppRole: metaCode.GetMetaForAppRole(),
-		MetaValidFrom: time.Unix(metaCode.GetMetaValidFrom().Seconds, 0),
-		MetaValidTo: time.Unix(metaCode.GetMetaValidTo().Seconds, 0),
-		CreatedAt: time.Now(),
-		IsDeleted: metaCode.GetIsDeleted(),
-		IsFixture: metaCode.GetIsFixture(),
-		IsTest: metaCode.GetIsTest(),
-	}
-
-	log.Infof("%s: CreateInviteCode: receive gRPC invite-guid: %s",metaServiceName,metaData.MetaCode)
-	result, err := metaMongoDbCollection.InsertOne(metaMongoDbContext, metaData)
+	metaData, err := inviteCodeFromProto(req.GetInviteCode())
 	if err != nil {
-		log.Warnf("%s: mongodb: error during gRPC based insert operation of invite-guid xxx",metaServiceName)
-		return nil, status.Errorf(
-			codes.Internal,
-			fmt.Sprintf("Internal error: %v", err),
-		)
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Could not map invite code: %v", err))
 	}
 
-	metaCode.Id = result.InsertedID.(primitive.ObjectID).Hex()
-	log.Infof("%s: CreateInviteCode: persist gRPC oid: %s",metaServiceName,metaCode.Id)
+	// id and timestamps are server controlled, mongodb stores milliseconds only
+	metaData.ID, metaData.CreatedAt, metaData.UpdatedAt, metaData.DeletedAt = primitive.NewObjectID(), time.Now().UTC().Truncate(time.Millisecond), time.Time{}, time.Time{}
+	metaData.MetaValidFrom, metaData.MetaValidTo = metaData.MetaValidFrom.Truncate(time.Millisecond), metaData.MetaValidTo.Truncate(time.Millisecond)
+	metaData.Version = metaInviteCodeInitialVersion
 
-	return &rfpb.CreateInviteCodeRes{ InviteCode: metaCode }, nil
-}
+	log.Infof("%s: CreateInviteCode: receive gRPC invite-code: %s",metaServiceName,metaData.MetaCode)
+	opCtx, cancel := mongoDbOperationContext(ctx, configCurrent().MongoDbOperationTimeout)
+	defer cancel()
+
+	// the invite code, its created event and audit event are committed together (transactional outbox)
+	err = outboxTransaction(opCtx, func(txCtx context.Context) error {
+		if _, err := mongoDbCurrent().collection.InsertOne(txCtx, metaData); err != nil { return err }
+		if err := outboxEnqueue(txCtx, metaInviteCodeEventCreated, metaData); err != nil { return err }
+		return auditRecord(txCtx, auditOperationCreate, nil, metaData)
+	})
+	if err != nil {
+		err = inviteCodeWriteError(opCtx, err, "", metaData.MetaCode)
+		log.Warnf("%s: mongodb: unable to insert invite-code %s (reason: %s)",metaServiceName,metaData.MetaCode,domainErrorReason(err))
+		return nil, err
+	}
+
+	log.Infof("%s: CreateInviteCode: persist gRPC oid: %s",metaServiceName,metaData.ID.Hex())
+	inviteCodeSetETag(ctx, metaData.Version)
 
-func (u UserInviteCodeServiceServer) GetInviteCode(_ context.Context, req *rfpb.GetInviteCodeReq) (*rfpb.GetInviteCodeRes, error) {
+	return &rfpb.CreateInviteCodeRes{ InviteCode: inviteCodeToProto(metaData) }, nil
+}
 
-	_handleSimLoadLatency()
+func (u UserInviteCodeServiceServer) GetInviteCode(ctx context.Context, req *rfpb.GetInviteCodeReq) (*rfpb.GetInviteCodeRes, error) {
 
 	// convert string id (from proto) to mongoDB ObjectId
 	oid, err := primitive.ObjectIDFromHex(req.GetId())
@@ -285,37 +384,26 @@ func (u UserInviteCodeServiceServer) GetInviteCode(_ context.Context, req *rfpb.
 	}
 
 	log.Infof("%s: GetInviteCode: receive gRPC invite-oid: %s",metaServiceName,req.GetId())
-	result := metaMongoDbCollection.FindOne(metaMongoDbContext, bson.M{"_id": oid, "is_deleted": false})
+	opCtx, cancel := mongoDbOperationContext(ctx, configCurrent().MongoDbOperationTimeout)
+	defer cancel()
+
+	result := mongoDbCurrent().collection.FindOne(opCtx, bson.M{"_id": oid, "is_deleted": false})
 
 	metaCode := UserInviteCode{}
 	if err := result.Decode(&metaCode); err != nil {
 		log.Warnf("%s: mongodb: unable to find document with object-id %s",metaServiceName,req.GetId())
-		return nil, status.Errorf(codes.NotFound,fmt.Sprintf("Get Code Fail (!) -> Error: %v", err))
-	}
-
-	// prepare some core type variables
-	tsMetaValidFrom, _ := ptypes.TimestampProto(metaCode.MetaValidFrom)
-	tsMetaValidTo,   _ := ptypes.TimestampProto(metaCode.MetaValidTo)
-	tsMetaCreatedAt, _ := ptypes.TimestampProto(metaCode.CreatedAt)
-	// Cast to GetInviteCodeRes type
-	response := &rfpb.GetInviteCodeRes{
-		InviteCode: &rfpb.UserInviteCode{
-			Id:       oid.Hex(),
-			MetaCode: metaCode.MetaCode,
-			MetaForAppRole: metaCode.MetaForAppRole,
-			MetaValidFrom: tsMetaValidFrom,
-			MetaValidTo: tsMetaValidTo,
-			CreatedAt: tsMetaCreatedAt,
-			IsFixture: metaCode.IsFixture,
-		},
+		return nil, mongoDbDomainError(opCtx, err, req.GetId())
 	}
 
-	return response, nil
-}
+	if !req.GetIncludeExpired() {
+		if err := inviteCodeExpiredError(&metaCode, time.Now()); err != nil { return nil, err }
+	}
+	inviteCodeSetETag(ctx, metaCode.Version)
 
-func (u UserInviteCodeServiceServer) DeleteInviteCode(_ context.Context, req *rfpb.DeleteInviteCodeReq) (*rfpb.DeleteInviteCodeRes, error) {
+	return &rfpb.GetInviteCodeRes{ InviteCode: inviteCodeToProto(&metaCode) }, nil
+}
 
-	_handleSimLoadLatency()
+func (u UserInviteCodeServiceServer) DeleteInviteCode(ctx context.Context, req *rfpb.DeleteInviteCodeReq) (*rfpb.DeleteInviteCodeRes, error) {
 
 	oid, err := primitive.ObjectIDFromHex(req.GetId())
 	if err != nil {
@@ -323,27 +411,35 @@ func (u UserInviteCodeServiceServer) DeleteInviteCode(_ context.Context, req *rf
 		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Could not convert to ObjectId: %v", err))
 	}
 
-	result := metaMongoDbCollection.FindOneAndUpdate(metaMongoDbContext,
-		bson.M{"_id": oid, "is_deleted": false},
-		bson.M{"$set": bson.M{
-			"is_deleted": true,
-			"deleted_at": time.Now(),
-		}},  options.FindOneAndUpdate().SetReturnDocument(1))
+	expected, err := inviteCodeExpectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil { return nil, err }
+
+	opCtx, cancel := mongoDbOperationContext(ctx, configCurrent().MongoDbOperationTimeout)
+	defer cancel()
 
+	before, decoded := UserInviteCode{}, UserInviteCode{}
+	err = outboxTransaction(opCtx, func(txCtx context.Context) error {
+		result := mongoDbCurrent().collection.FindOneAndUpdate(txCtx,
+			inviteCodeVersionFilter(bson.M{"_id": oid, "is_deleted": false}, expected),
+			bson.M{"$set": bson.M{
+				"is_deleted": true,
+				"deleted_at": time.Now(),
+			}, "$inc": bson.M{"version": 1}},  options.FindOneAndUpdate().SetReturnDocument(options.Before))
 
-	decoded := UserInviteCode{}
-	err = result.Decode(&decoded)
+		if err := result.Decode(&before); err != nil { return err }
+		if err := mongoDbCurrent().collection.FindOne(txCtx, bson.M{"_id": oid}).Decode(&decoded); err != nil { return err }
+		if err := outboxEnqueue(txCtx, metaInviteCodeEventDeleted, &decoded); err != nil { return err }
+		return auditRecord(txCtx, auditOperationDelete, &before, &decoded)
+	})
 	if err != nil {
 		log.Warnf("%s: mongodb: unable to find invite-code with supplied ID: %s",metaServiceName,oid)
-		return nil, status.Errorf(codes.NotFound,fmt.Sprintf("Delete Code Fail (!) -> Error: %v", err))
+		return nil, inviteCodeVersionError(opCtx, oid, expected, err)
 	}
 
 	return &rfpb.DeleteInviteCodeRes{ Success: true }, nil
 }
 
-func (u UserInviteCodeServiceServer) UpdateInviteCode(_ context.Context, req *rfpb.UpdateInviteCodeReq) (*rfpb.UpdateInviteCodeRes, error) {
-
-	_handleSimLoadLatency()
+func (u UserInviteCodeServiceServer) UpdateInviteCode(ctx context.Context, req *rfpb.UpdateInviteCodeReq) (*rfpb.UpdateInviteCodeRes, error) {
 
 	metaCode := req.GetInviteCode()
 	oid, err := primitive.ObjectIDFromHex(metaCode.GetId())
@@ -352,231 +448,153 @@ func (u UserInviteCodeServiceServer) UpdateInviteCode(_ context.Context, req *rf
 		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("Could not convert to ObjectId: %v", err))
 	}
 
-	res := metaMongoDbCollection.FindOneAndUpdate(metaMongoDbContext,
-		bson.M{"_id": oid, "is_deleted": false},
-		bson.M{"$set": bson.M{
-				"meta_code": metaCode.MetaCode,
-				"meta_for_app_role": metaCode.MetaForAppRole,
-				"meta_valid_from": metaCode.MetaValidFrom.AsTime(),
-				"meta_valid_to": metaCode.MetaValidTo.AsTime(),
-				"updated_at": ptypes.TimestampNow(),
-			}},  options.FindOneAndUpdate().SetReturnDocument(1))
+	// only the paths of the update mask (or the populated mutable fields) are validated and set
+	paths, err := inviteCodeUpdatePaths(req)
+	if err != nil { return nil, err }
 
-	decoded := UserInviteCode{}
-	err = res.Decode(&decoded)
-	if err != nil {
-		log.Warnf("%s: mongodb: unable to find document with oid: %s",metaServiceName,oid)
-		return nil, status.Errorf(codes.NotFound,fmt.Sprintf("Update Code Fail (!) -> Error: %v", err))
-	}
+	update, err := inviteCodeUpdate(metaCode, paths, time.Now())
+	if err != nil { return nil, err }
 
-	tsMetaValidFrom, _ := ptypes.TimestampProto(decoded.MetaValidFrom)
-	tsMetaValidTo,   _ := ptypes.TimestampProto(decoded.MetaValidTo)
+	// concurrent writers are detected by the document version (request field or if-match header)
+	expected, err := inviteCodeExpectedVersion(ctx, req.GetExpectedVersion())
+	if err != nil { return nil, err }
 
-	return &rfpb.UpdateInviteCodeRes{
-		InviteCode: &rfpb.UserInviteCode{
-			Id:       		decoded.ID.Hex(),
-			MetaCode: 		decoded.MetaCode,
-			MetaForAppRole: decoded.MetaForAppRole,
-			MetaValidFrom:  tsMetaValidFrom,
-			MetaValidTo:  	tsMetaValidTo,
-		},
-	}, nil
-}
+	opCtx, cancel := mongoDbOperationContext(ctx, configCurrent().MongoDbOperationTimeout)
+	defer cancel()
 
-func (u UserInviteCodeServiceServer) ListInviteCodes(_ *rfpb.ListInviteCodeReq, stream rfpb.UserInviteCodeService_ListInviteCodesServer) error {
+	// a validity updated on one side only is checked against the stored other side
+	filter := inviteCodeVersionFilter(bson.M{"_id": oid, "is_deleted": false}, expected)
+	guard := inviteCodeValidityGuard(metaCode, paths)
+	if guard != nil {
+		filter["$expr"] = guard["$expr"]
+	}
 
-	_handleSimLoadLatency()
+	// the audit event (before/after) is committed with the update
+	before, decoded := UserInviteCode{}, UserInviteCode{}
+	err = outboxTransaction(opCtx, func(txCtx context.Context) error {
+		res := mongoDbCurrent().collection.FindOneAndUpdate(txCtx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.Before))
 
-	data := &UserInviteCode{}
-	cursor, err := metaMongoDbCollection.Find(metaMongoDbContext, bson.M{"is_deleted": false})
+		if err := res.Decode(&before); err != nil { return err }
+		if err := mongoDbCurrent().collection.FindOne(txCtx, bson.M{"_id": oid}).Decode(&decoded); err != nil { return err }
+		return auditRecord(txCtx, auditOperationUpdate, &before, &decoded)
+	})
 	if err != nil {
-		log.Warnf("%s: mongodb: unable to find invite-code(s)",metaServiceName)
-		return status.Errorf(codes.Internal, fmt.Sprintf("Unknown internal error: %v", err))
-	};  defer cursor.Close(metaMongoDbContext)
-
-	for cursor.Next(metaMongoDbContext) {
-		err := cursor.Decode(data)
-		if err != nil {
-			return status.Errorf(codes.Unavailable, fmt.Sprintf("Could not decode data: %v", err))
+		if _isMongoDbDuplicateKey(err) {
+			return nil, inviteCodeWriteError(opCtx, err, oid.Hex(), metaCode.GetMetaCode())
+		}
+		if validityErr := inviteCodeValidityError(opCtx, oid, guard, paths, err); validityErr != nil {
+			return nil, validityErr
 		}
-		// prepare some core type variables
-		tsMetaValidFrom, _ := ptypes.TimestampProto(data.MetaValidFrom)
-		tsMetaValidTo,   _ := ptypes.TimestampProto(data.MetaValidTo)
-		// if no error is found send invite codes via stream
-		_ = stream.Send(&rfpb.ListInviteCodeRes{
-			InviteCode: &rfpb.UserInviteCode{
-				Id:             data.ID.Hex(),
-				MetaCode:       data.MetaCode,
-				MetaForAppRole: data.MetaForAppRole,
-				MetaValidFrom:  tsMetaValidFrom,
-				MetaValidTo:    tsMetaValidTo,
-			},
-		})
+		log.Warnf("%s: mongodb: unable to find document with oid: %s",metaServiceName,oid)
+		return nil, inviteCodeVersionError(opCtx, oid, expected, err)
 	}
+	inviteCodeSetETag(ctx, decoded.Version)
 
-	if err := cursor.Err(); err != nil {
-		return status.Errorf(codes.Internal, fmt.Sprintf("Unkown cursor error: %v", err))
-	}
+	return &rfpb.UpdateInviteCodeRes{ InviteCode: inviteCodeToProto(&decoded) }, nil
+}
 
-	return nil
+func (u UserInviteCodeServiceServer) ListInviteCodes(req *rfpb.ListInviteCodeReq, stream rfpb.UserInviteCodeService_ListInviteCodesServer) error {
+
+	filter := inviteCodeExpiryFilter(bson.M{"is_deleted": false}, req.GetIncludeExpired(), time.Now())
+
+	return _streamInviteCodes(stream, filter, func(inviteCode *rfpb.UserInviteCode) error {
+		return stream.Send(&rfpb.ListInviteCodeRes{InviteCode: inviteCode})
+	})
 }
 
 func (u UserInviteCodeServiceServer) ListFilteredInviteCodes(req *rfpb.ListFilteredInviteCodeReq, stream rfpb.UserInviteCodeService_ListFilteredInviteCodesServer) error {
 
-	_handleSimLoadLatency()
-
-	data := &UserInviteCode{}
-	cursor, err := metaMongoDbCollection.Find(metaMongoDbContext, _getBSONFilterByRequest(req))
-	if err != nil {
-		log.Warnf("%s: mongodb: unable to find invite-code(s)",metaServiceName)
-		return status.Errorf(codes.Internal, fmt.Sprintf("Unknown internal error: %v", err))
-	}
+	// if no error is found send filter results (invite codes) via stream
+	filter := inviteCodeExpiryFilter(*_getBSONFilterByRequest(req), req.GetFilter().GetIncludeExpired(), time.Now())
 
-	defer cursor.Close(metaMongoDbContext)
-	for cursor.Next(metaMongoDbContext) {
-		err := cursor.Decode(data)
-		if err != nil {
-			return status.Errorf(codes.Unavailable, fmt.Sprintf("Could not decode data: %v", err))
-		}
-		// prepare some core type variables
-		tsMetaValidFrom, _ := ptypes.TimestampProto(data.MetaValidFrom)
-		tsMetaValidTo,   _ := ptypes.TimestampProto(data.MetaValidTo)
-		// if no error is found send filter results (invite codes) via stream
-		_ = stream.Send(&rfpb.ListFilteredInviteCodeRes{
-			InviteCode: &rfpb.UserInviteCode{
-				Id:             data.ID.Hex(),
-				MetaCode:       data.MetaCode,
-				MetaForAppRole: data.MetaForAppRole,
-				MetaValidFrom:  tsMetaValidFrom,
-				MetaValidTo:    tsMetaValidTo,
-			},
-		})
-	}
+	return _streamInviteCodes(stream, filter, func(inviteCode *rfpb.UserInviteCode) error {
+		return stream.Send(&rfpb.ListFilteredInviteCodeRes{InviteCode: inviteCode})
+	})
+}
 
-	if err := cursor.Err(); err != nil {
-		return status.Errorf(codes.Internal, fmt.Sprintf("Unkown cursor error: %v", err))
-	}
+func (u UserInviteCodeServiceServer) WatchInviteCodes(req *rfpb.WatchInviteCodesReq, stream rfpb.UserInviteCodeService_WatchInviteCodesServer) error {
 
-	return nil
+	return inviteCodeWatch(stream.Context(), req, newInviteCodeWatcher(configCurrent()), func(res *rfpb.WatchInviteCodesRes) error {
+		return stream.Send(res)
+	})
 }
 
 //
 // -- gRPC MongoDb Stack 3/n :: MongoDbOps
 //
 
-func mongoDbFixtureClean() error {
-
-	dr, err := metaMongoDbCollection.DeleteMany(metaMongoDbContext, bson.D{{}})
+// mongoDbConnect creates the client and collections of the service database, the client connects in the background
+// (mongo.Connect doesn't wait for the server), so it is called before serving starts.
+func mongoDbConnect() {
 
-	if err != nil { return err }
-	if dr.DeletedCount > 0 {
-		log.Infof("%s: mongodb: delete %v documents in [%s] metaMongoDbCollection",metaServiceName,dr.DeletedCount,metaMongoDbCollection.Name())
-	}
+	client, err := mongo.Connect(metaMongoDbContext, mongoDbClientOptions(configCurrent()))
+	if err != nil { log.Fatal(err) }
 
-	return nil
+	mongoDbSwapConnection(newMongoDbConnection(client, configCurrent()))
 }
 
-func mongoDbFixtureLoadInviteCodes() error {
-
-	var (
-		maxAdminCodes = 10
-		maxPowerUserCodes = 3
-		maxTeacherCodes = 99
-		maxViewerCodes = 5
-	)
-
-	if err = mongoDbFixtureClean(); err != nil { return err }
+// mongoDbInitCon waits until mongodb is reachable (mongoDbConnect has to be called first), then migrates the schema
+// and starts all database workers.
+func mongoDbInitCon() {
 
-	// -- load [user_invitation_codes] metaMongoDbCollection for [admins] --
-	log.Infof("%s: mongodb: generate [admin] fixtures in metaMongoDbCollection [%s]",metaServiceName,metaMongoDbCollectionTbl)
-	for c := 0; c < maxAdminCodes; c++ {
-		if _genUserInviteCode("admin") != nil {
-			return err
-		}
+	conn := mongoDbCurrent()
+	if err := mongoDbWaitForConnection(conn.client, configCurrent()); err != nil {
+		log.Fatalf("%s: mongodb: %v <exit>",metaServiceName,err)
 	}
 
-	// -- load [user_invitation_codes] metaMongoDbCollection for [directors (powerUsers)] --
-	log.Infof("%s: mongodb: generate [director] fixtures in metaMongoDbCollection [%s]",metaServiceName,metaMongoDbCollectionTbl)
-	for c := 0; c < maxPowerUserCodes; c++ {
-		if _genUserInviteCode("director") != nil {
-			return err
-		}
+	// replicas migrate one at a time (lock), a failed migration (e.g. duplicate codes) keeps health NOT_SERVING
+	if configCurrent().MigrateOnStartup {
+		mongoDbMigrateOnStartup(mongoDbMigrate, configCurrent().MongoDbConnectBackoff, configCurrent().MongoDbConnectMaxBackoff)
 	}
 
-	// -- load [user_invitation_codes] metaMongoDbCollection for [teacher] --
-	log.Infof("%s: mongodb: generate [teacher] fixtures in metaMongoDbCollection [%s]",metaServiceName,metaMongoDbCollectionTbl)
-	for c := 0; c < maxTeacherCodes; c++ {
-		if _genUserInviteCode("teacher") != nil {
-			return err
-		}
+	// standalone servers reject invite code writes without OUTBOX_ALLOW_NON_TRANSACTIONAL
+	if err := outboxCheckTransactions(conn.database); err != nil {
+		log.Errorf("%s: outbox: %v",metaServiceName,err)
 	}
 
-	// -- load [user_invitation_codes] metaMongoDbCollection for [viewer] --
-	log.Infof("%s: mongodb: generate [viewer] fixtures in metaMongoDbCollection [%s]",metaServiceName,metaMongoDbCollectionTbl)
-	for c := 0; c < maxViewerCodes; c++ {
-		if _genUserInviteCode("viewer") != nil {
-			return err
-		}
+	// expired codes are removed by mongodb after the grace period (INVITE_CODE_EXPIRY_TTL), the TTL index bypasses
+	// soft delete, outbox event and audit log
+	if err := mongoDbEnsureExpiryIndex(conn.collection, configCurrent().InviteCodeExpiryTTL); err != nil {
+		log.Errorf("%s: mongodb: unable to ensure expiry index: %v",metaServiceName,err)
 	}
 
-	return nil
-}
-
-func mongoDbFixtureHandleFatal(err error) {
+	runtimeSetMongoDbConnected(true)
+	mongoDbStartMonitor(configCurrent().MongoDbMonitorInterval)
+	expiryStartScheduler(configCurrent())
+	metaWebhooks.startWorker(configCurrent().WebhookPollInterval)
+	metaOutbox.start(configCurrent().OutboxPollInterval)
 
-	mongoDbCloseCon()
-	log.Fatal(err)
+	log.Infof("%s: mongodb: connection opened (app=%s, pool=%d..%d, list-read-preference=%s)",metaServiceName,
+		configCurrent().MongoDbAppName,configCurrent().MongoDbMinPoolSize,configCurrent().MongoDbMaxPoolSize,configCurrent().MongoDbListReadPreference)
 }
 
-func mongoDbFixtureCreateIndexes() error {
+// mongoDbReconnect opens a new connection using rotated credentials, the previous connection is
+// closed once the new one has been verified, it is kept if the new credentials don't work.
+func mongoDbReconnect(cfg *Config) error {
 
-	// create index for meta_valid_from, meta_valid_to and meta_code (unique)
-	models := []mongo.IndexModel{
-		{
-			Keys: bsonx.Doc{{Key: "meta_valid_from", Value: bsonx.Int32(-1)}},
-			Options: options.Index().SetName("meta_valid_from_idx").SetSparse(true),
-		},
-		{
-			Keys: bsonx.Doc{{Key: "meta_valid_to", Value: bsonx.Int32(-1)}},
-			Options: options.Index().SetName("meta_valid_to_idx").SetSparse(true),
-		},
-        {
-			Keys: bsonx.Doc{{Key: "meta_code", Value: bsonx.String("text")}},
-			Options: options.Index().SetName("meta_code_idx").SetUnique(true),
-        },
-	}
-
-	opts := options.CreateIndexes().SetMaxTime(10 * time.Second)
-	_, err = metaMongoDbCollection.Indexes().CreateMany(metaMongoDbContext, models, opts)
+	client, err := _newMongoDbClient(cfg)
 	if err != nil { return err }
 
-	return nil
-}
-
-func mongoDbInitCon() {
-
-	clientOptions := options.Client().ApplyURI(metaMongoDbLnk).SetAuth(options.Credential{
-		AuthSource: metaMongoDbPDB, Username: metaMongoDbUsr, Password: metaMongoDbPwd,
-	})
-
-	// declare Context type object for managing multiple API requests
-	metaMongoDbContext, _ := context.WithTimeout(context.Background(), 10*time.Second)
-	metaMongoDbClient, err = mongo.Connect(metaMongoDbContext, clientOptions)
-	if err != nil { log.Fatal(err) }
-
-	err = metaMongoDbClient.Ping(metaMongoDbContext, nil)
-	if err != nil {
-		log.Fatal(err)
+	previous := mongoDbSwapConnection(newMongoDbConnection(client, cfg))
+	if previous != nil {
+		go func() {
+			disconnectCtx, cancel := context.WithTimeout(context.Background(), configCurrent().DrainTimeout)
+			defer cancel()
+			_ = previous.client.Disconnect(disconnectCtx)
+		}()
 	}
 
-	metaMongoDbCollection = metaMongoDbClient.Database(metaMongoDbPDB).Collection(metaMongoDbCollectionTbl)
+	log.Infof("%s: mongodb: connection re-opened using rotated credentials",metaServiceName)
 
-	log.Infof("%s: mongodb: connection opened",metaServiceName)
+	return nil
 }
 
 func mongoDbCloseCon() {
 
-	err = metaMongoDbClient.Disconnect(metaMongoDbContext)
+	conn := mongoDbCurrent()
+	if conn == nil { return }
+
+	err = conn.client.Disconnect(metaMongoDbContext)
 	if err != nil { log.Fatal(err) }
 
 	log.Infof("%s: mongodb: connection closed",metaServiceName)
@@ -586,38 +604,6 @@ func mongoDbCloseCon() {
 // -- sidekick stack for MongoDbOps && (some) gRPC helper methods
 //
 
-func _genUserInviteCodeULID() string {
-
-	t := time.Now().UTC()
-	entropy := ulid.Monotonic(rand.New(rand.NewSource(t.UnixNano())), 0)
-
-	return fmt.Sprintf("%s", ulid.MustNew(ulid.Timestamp(t), entropy))
-}
-
-func _genUserInviteCode(forRole string) error {
-
-	inviteCode := _genUserInviteCodeULID()
-	createdAt  := time.Now()
-	validFrom  := createdAt.Add(time.Second * time.Duration(rand.Float32() * 60))
-	validTo    := validFrom.Add(time.Hour * 8760) // add 1 year in hours
-
-	_, err = metaMongoDbCollection.InsertOne(metaMongoDbContext, UserInviteCode{
-		IsFixture: true,
-		MetaCode: inviteCode,
-		MetaForAppRole: forRole,
-		MetaValidFrom: validFrom,
-		MetaValidTo: validTo,
-		CreatedAt: createdAt,
-	}); if err != nil {
-		log.Infof("%s: mongodb: add code [%s]-[%s] failed, code may already exists ...",metaServiceName,inviteCode,forRole)
-		return err
-	}
-
-	log.Infof("%s: mongodb: add code [%s]-[%s]",metaServiceName,inviteCode,forRole)
-
-	return nil
-}
-
 func _getBSONFilterByRequest(req *rfpb.ListFilteredInviteCodeReq) *bson.M {
 
 	dataFilter := &bson.M{}
@@ -646,34 +632,106 @@ func _getBSONFilterByRequest(req *rfpb.ListFilteredInviteCodeReq) *bson.M {
 	return dataFilter
 }
 
-//
-// -- sidekick stack for env related helper methods
-//
+// listCursor is the part of *mongo.Cursor used by the List RPCs
+type listCursor interface {
+	Next(ctx context.Context) bool
+	Decode(val interface{}) error
+	Err() error
+}
 
-func _getDotEnvVariable(key string, file ...string) string {
+// _streamInviteCodes runs a List RPC query and streams all results, the number of sent codes and
+// whether the result was truncated (DB_MONGO_LIST_MAX_RESULTS) or aborted are reported as trailers.
+func _streamInviteCodes(stream grpc.ServerStream, filter interface{}, send func(*rfpb.UserInviteCode) error) error {
 
-	envFile := ".env"
-	if len(file) > 0 {
-		envFile = file[0]
+	opCtx, cancel := mongoDbOperationContext(stream.Context(), configCurrent().MongoDbListTimeout)
+	defer cancel()
+
+	// fetch one more document than allowed to detect truncated results
+	findOptions := options.Find().SetBatchSize(int32(configCurrent().MongoDbListBatchSize))
+	if configCurrent().MongoDbListMaxResults > 0 {
+		findOptions.SetLimit(int64(configCurrent().MongoDbListMaxResults) + 1)
+	}
+
+	cursor, err := mongoDbCurrent().listCollection.Find(opCtx, filter, findOptions)
+	if err != nil {
+		log.Warnf("%s: mongodb: unable to find invite-code(s)",metaServiceName)
+		stream.SetTrailer(_getListTrailer(0, false, true))
+		return mongoDbDomainError(opCtx, err, "")
+	};  defer cursor.Close(metaMongoDbContext)
+
+	sent, truncated, err := _sendInviteCodes(opCtx, cursor, configCurrent().MongoDbListMaxResults, send)
+	stream.SetTrailer(_getListTrailer(sent, truncated, err != nil))
+	if err != nil {
+		log.Warnf("%s: list stream aborted after %d invite-code(s): %v",metaServiceName,sent,err)
+		return err
 	}
 
-	if err := godotenv.Load(envFile) ; err != nil {
-		log.Fatalf("common: error loading local [.%s] file",file)
+	if truncated {
+		log.Infof("%s: list stream truncated after %d invite-code(s)",metaServiceName,sent)
 	}
 
-	return os.Getenv(key)
+	return nil
+}
+
+// _sendInviteCodes sends the cursor results until the cursor is exhausted, maxResults (0 = unlimited) is
+// reached, the context is done or a send fails (e.g. client gone).
+func _sendInviteCodes(opCtx context.Context, cursor listCursor, maxResults int, send func(*rfpb.UserInviteCode) error) (int, bool, error) {
+
+	sent := 0
+	for cursor.Next(opCtx) {
+		// cursor.Next serves buffered documents without checking the context
+		if opCtx.Err() != nil {
+			return sent, false, mongoDbStatusError(opCtx, opCtx.Err(), codes.Internal, "context done")
+		}
+
+		if maxResults > 0 && sent >= maxResults {
+			return sent, true, nil
+		}
+
+		data := UserInviteCode{}
+		if err := cursor.Decode(&data); err != nil {
+			return sent, false, mongoDbDomainError(opCtx, err, "")
+		}
+
+		err := send(inviteCodeToProto(&data))
+		if err != nil {
+			return sent, false, mongoDbStatusError(opCtx, err, codes.Unavailable, "Could not send data, client gone")
+		}
+		sent++
+	}
+
+	if err := cursor.Err(); err != nil {
+		return sent, false, mongoDbDomainError(opCtx, err, "")
+	}
+
+	return sent, false, nil
+}
+
+func _getListTrailer(sent int, truncated bool, aborted bool) metadata.MD {
+
+	return metadata.Pairs(
+		metaListTrailerCount, strconv.Itoa(sent),
+		metaListTrailerTruncated, strconv.FormatBool(truncated),
+		metaListTrailerPartial, strconv.FormatBool(aborted),
+	)
 }
 
 //
-// -- sidekick stack for load-test helper methods
+// -- sidekick stack for MongoDbOps helper methods
 //
-func _handleSimLoadLatency() bool {
 
-	if  extraLatency == time.Duration(0) {
-		return false
-	}
+func _newMongoDbClient(cfg *Config) (*mongo.Client, error) {
+
+	connectCtx, cancel := context.WithTimeout(context.Background(), cfg.MongoDbConnectTimeout)
+	defer cancel()
 
-	time.Sleep(extraLatency)
+	client, err := mongo.Connect(connectCtx, mongoDbClientOptions(cfg))
+	if err != nil { return nil, err }
 
-	return true
-}
\ No newline at end of file
+	if err := client.Ping(connectCtx, nil); err != nil {
+		_ = client.Disconnect(context.Background())
+		return nil, err
+	}
+
+	return client, nil
+}
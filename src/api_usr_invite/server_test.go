@@ -25,8 +25,12 @@ import (
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"io"
+	"math/rand"
 	"net"
 	"os"
 	"regexp"
@@ -44,20 +48,29 @@ var ctx = context.Background()
 
 func tearDBUp() {
 
+	mongoDbConnect()
 	mongoDbInitCon()
 }
 
 func tearDBDown(t *testing.T) {
 
-	err = mongoDbFixtureClean()
+	err = mongoDbFixtureClean(true)
 	if err != nil { t.Fatal(err) }
 	mongoDbCloseCon()
 }
 
+func _genUserInviteCodeULID() string {
+
+	t := time.Now().UTC()
+	entropy := ulid.Monotonic(rand.New(rand.NewSource(t.UnixNano())), 0)
+
+	return fmt.Sprintf("%s", ulid.MustNew(ulid.Timestamp(t), entropy))
+}
+
 func grpcDialer() func(context.Context, string) (net.Conn, error) {
 
 	listener := bufconn.Listen(1024 * 1024)
-	server := grpc.NewServer()
+	server := grpc.NewServer(serverOptions()...)
 	rfpb.RegisterUserInviteCodeServiceServer(server, &UserInviteCodeServiceServer{})
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -84,30 +97,13 @@ func TestMain(m *testing.M) {
 
 func setup() {
 
-	if metaMongoDbUsr = _getDotEnvVariable("DB_MONGO_USR"); metaMongoDbUsr == "" {
-		fmt.Printf("%s: mongoDB-Service-User not set <exit>",metaTestScope)
-		os.Exit(1)
-	}
-
-	if metaMongoDbPwd = _getDotEnvVariable("DB_MONGO_PWD"); metaMongoDbPwd == "" {
-		fmt.Printf("%s: mongoDB-Password not set <exit>",metaTestScope)
-		os.Exit(1)
-	}
-
-	if metaMongoDbPDB = _getDotEnvVariable("DB_MONGO_PDB"); metaMongoDbPDB == "" {
-		fmt.Printf("%s: mongoDB primary service db not found <exit>",metaTestScope)
-		os.Exit(1)
-	}
-
-	if metaMongoDbLnk = _getDotEnvVariable("DB_MONGO_LNK"); metaMongoDbLnk == "" {
-		fmt.Printf("%s: mongoDB connection link not found <exit>",metaTestScope)
+	cfg, err := loadConfig(nil)
+	if err != nil {
+		fmt.Printf("%s: %v <exit>",metaTestScope,err)
 		os.Exit(1)
 	}
 
-	if metaServicePort = _getDotEnvVariable("PORT"); metaServicePort == "" {
-		fmt.Printf("%s: service port definition missing <exit>",metaTestScope)
-		os.Exit(1)
-	}
+	configure(cfg)
 
 	fmt.Printf("\033[1;36m%s\033[0m", "> Setup completed\n")
 }
@@ -145,9 +141,9 @@ func TestRegisterUserInviteCodeServiceServer_CreateInviteCode(t *testing.T) {
 	client := rfpb.NewUserInviteCodeServiceClient(conn)
 
 	MetaInviteCode := _genUserInviteCodeULID()
-	MetaInviteRole := "ninja"
+	MetaInviteRole := "teacher"
 	tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now())
-	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now())
+	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
 	inviteCode := rfpb.UserInviteCode{
 		MetaCode:       MetaInviteCode,
 		MetaForAppRole: MetaInviteRole,
@@ -168,6 +164,72 @@ func TestRegisterUserInviteCodeServiceServer_CreateInviteCode(t *testing.T) {
 	tearDBDown(t)
 }
 
+func TestRegisterUserInviteCodeServiceServer_CreateInviteCodeDuplicate(t *testing.T) {
+
+	tearDBUp()
+
+	conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(grpcDialer()))
+	if err != nil { log.Fatal(err) }; defer conn.Close()
+
+	client := rfpb.NewUserInviteCodeServiceClient(conn)
+
+	MetaInviteCode := _genUserInviteCodeULID()
+	tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now())
+	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	inviteCode := rfpb.UserInviteCode{
+		MetaCode:       MetaInviteCode,
+		MetaForAppRole: "teacher",
+		MetaValidFrom:  tsMetaValidFrom,
+		MetaValidTo:    tsMetaValidTo,
+		IsTest:         true,
+	}
+
+	resCreate, err := client.CreateInviteCode(ctx, &rfpb.CreateInviteCodeReq{ InviteCode: &inviteCode })
+	if err != nil { tearDBDown(t); t.Fatal(err) }
+
+	// the unique index (ensured on startup) rejects the same code, the conflicting code is named in the details
+	_, err = client.CreateInviteCode(ctx, &rfpb.CreateInviteCodeReq{ InviteCode: &inviteCode })
+	code, info := errorsTestInfo(t, err)
+	assert.Equal(t, codes.AlreadyExists, code)
+	assert.Equal(t, string(reasonDuplicateCode), info.GetReason())
+	assert.Equal(t, MetaInviteCode, info.GetMetadata()["meta_code"])
+
+	// ... unless the first one has been deleted
+	_, err = client.DeleteInviteCode(ctx, &rfpb.DeleteInviteCodeReq{ Id: resCreate.InviteCode.Id })
+	if err != nil { tearDBDown(t); t.Fatal(err) }
+	_, err = client.CreateInviteCode(ctx, &rfpb.CreateInviteCodeReq{ InviteCode: &inviteCode })
+	assert.NoError(t, err)
+
+	tearDBDown(t)
+}
+
+func TestRegisterUserInviteCodeServiceServer_CreateInviteCodeInvalid(t *testing.T) {
+
+	tearDBUp()
+
+	conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(grpcDialer()))
+	if err != nil { log.Fatal(err) }; defer conn.Close()
+
+	client := rfpb.NewUserInviteCodeServiceClient(conn)
+
+	tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now())
+	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	inviteCode := rfpb.UserInviteCode{
+		MetaCode:       _genUserInviteCodeULID(),
+		MetaForAppRole: "ninja",
+		MetaValidFrom:  tsMetaValidFrom,
+		MetaValidTo:    tsMetaValidTo,
+		IsTest:         true,
+	}
+
+	// unknown roles are rejected by the validation interceptor before the store is touched
+	_, err = client.CreateInviteCode(ctx, &rfpb.CreateInviteCodeReq{ InviteCode: &inviteCode })
+	violations := validateTestViolations(t, err)
+	assert.Contains(t, violations["inviteCode.meta_for_app_role"], "unknown role [ninja]")
+
+	tearDBDown(t)
+}
+
 func TestRegisterUserInviteCodeServiceServer_UpdateInviteCode(t *testing.T) {
 
 	tearDBUp()
@@ -178,9 +240,9 @@ func TestRegisterUserInviteCodeServiceServer_UpdateInviteCode(t *testing.T) {
 	client := rfpb.NewUserInviteCodeServiceClient(conn)
 
 	MetaInviteCode := _genUserInviteCodeULID()
-	MetaInviteRole := "ninja"
+	MetaInviteRole := "viewer"
 	tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now())
-	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now())
+	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
 	inviteCodeOrigin := rfpb.UserInviteCode{
 		MetaCode:       MetaInviteCode,
 		MetaForAppRole: MetaInviteRole,
@@ -198,7 +260,7 @@ func TestRegisterUserInviteCodeServiceServer_UpdateInviteCode(t *testing.T) {
 	tsMetaNewValidTo,   _ := ptypes.TimestampProto(tsMetaValidTo.AsTime().Add(time.Hour * time.Duration(2)))
 
 	MetaNewInviteCode := _genUserInviteCodeULID()
-	MetaNewInviteRole := "gopher"
+	MetaNewInviteRole := "teacher"
 	inviteCodeNew := rfpb.UserInviteCode{
 		Id:             resCreate.InviteCode.Id,
 		MetaCode:       MetaNewInviteCode,
@@ -217,6 +279,43 @@ func TestRegisterUserInviteCodeServiceServer_UpdateInviteCode(t *testing.T) {
 	assert.Equal(t, tsMetaNewValidFrom.Seconds, resUpdate.InviteCode.MetaValidFrom.Seconds)
 	assert.Equal(t, tsMetaNewValidTo.Seconds, resUpdate.InviteCode.MetaValidTo.Seconds)
 
+	// with an update mask only the listed paths are changed, the emptied code is left untouched
+	reqMasked := &rfpb.UpdateInviteCodeReq{
+		InviteCode: &rfpb.UserInviteCode{ Id: resCreate.InviteCode.Id, MetaForAppRole: "admin" },
+		UpdateMask: &fieldmaskpb.FieldMask{ Paths: []string{"meta_for_app_role"} },
+	}
+	resMasked, err := client.UpdateInviteCode(ctx, reqMasked)
+	if err != nil { tearDBDown(t); t.Fatal(err) }
+
+	assert.Equal(t, MetaNewInviteCode, resMasked.InviteCode.MetaCode)
+	assert.Equal(t, "admin", resMasked.InviteCode.MetaForAppRole)
+
+	reqMasked.UpdateMask.Paths = []string{"created_at"}
+	_, err = client.UpdateInviteCode(ctx, reqMasked)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// every write increments the version, writes based on a stale version are aborted
+	assert.Equal(t, resCreate.InviteCode.Version+2, resMasked.InviteCode.Version)
+	reqMasked.UpdateMask.Paths = []string{"meta_for_app_role"}
+	reqMasked.ExpectedVersion = resUpdate.InviteCode.Version
+	_, err = client.UpdateInviteCode(ctx, reqMasked)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	// a masked meta_valid_to is checked against the stored meta_valid_from
+	tsMetaEarlyValidTo, _ := ptypes.TimestampProto(tsMetaNewValidFrom.AsTime().Add(-time.Minute))
+	_, err = client.UpdateInviteCode(ctx, &rfpb.UpdateInviteCodeReq{
+		InviteCode: &rfpb.UserInviteCode{ Id: resCreate.InviteCode.Id, MetaValidTo: tsMetaEarlyValidTo },
+		UpdateMask: &fieldmaskpb.FieldMask{ Paths: []string{"meta_valid_to"} },
+	})
+	violations := validateTestViolations(t, err)
+	assert.Equal(t, "must be after the stored meta_valid_from", violations["inviteCode.meta_valid_to"])
+
+	_, err = client.DeleteInviteCode(ctx, &rfpb.DeleteInviteCodeReq{ Id: resCreate.InviteCode.Id, ExpectedVersion: resUpdate.InviteCode.Version })
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	_, err = client.DeleteInviteCode(ctx, &rfpb.DeleteInviteCodeReq{ Id: resCreate.InviteCode.Id, ExpectedVersion: resMasked.InviteCode.Version })
+	assert.NoError(t, err)
+
 	tearDBDown(t)
 }
 
@@ -230,9 +329,9 @@ func TestRegisterUserInviteCodeServiceServer_GetInviteCode(t *testing.T) {
 	client := rfpb.NewUserInviteCodeServiceClient(conn)
 
 	MetaInviteCode := _genUserInviteCodeULID()
-	MetaInviteRole := "ninja"
+	MetaInviteRole := "teacher"
 	tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now())
-	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now())
+	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
 	inviteCodeOrigin := rfpb.UserInviteCode{
 		MetaCode:       MetaInviteCode,
 		MetaForAppRole: MetaInviteRole,
@@ -255,6 +354,51 @@ func TestRegisterUserInviteCodeServiceServer_GetInviteCode(t *testing.T) {
 	tearDBDown(t)
 }
 
+func TestRegisterUserInviteCodeServiceServer_GetInviteCodeExpired(t *testing.T) {
+
+	tearDBUp()
+
+	conn, err := grpc.DialContext(ctx, "", grpc.WithInsecure(), grpc.WithContextDialer(grpcDialer()))
+	if err != nil { log.Fatal(err) }; defer conn.Close()
+
+	client := rfpb.NewUserInviteCodeServiceClient(conn)
+
+	tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now().Add(-2 * time.Hour))
+	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	resCreate, err := client.CreateInviteCode(ctx, &rfpb.CreateInviteCodeReq{ InviteCode: &rfpb.UserInviteCode{
+		MetaCode:       _genUserInviteCodeULID(),
+		MetaForAppRole: "teacher",
+		MetaValidFrom:  tsMetaValidFrom,
+		MetaValidTo:    tsMetaValidTo,
+		IsTest:         true,
+	}})
+	if err != nil { tearDBDown(t); t.Fatal(err) }
+
+	// expired codes are reported as CODE_EXPIRED and excluded from lists unless requested
+	_, err = client.GetInviteCode(ctx, &rfpb.GetInviteCodeReq{ Id: resCreate.InviteCode.Id })
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	resGet, err := client.GetInviteCode(ctx, &rfpb.GetInviteCodeReq{ Id: resCreate.InviteCode.Id, IncludeExpired: true })
+	if err != nil { tearDBDown(t); t.Fatal(err) }
+	assert.Equal(t, resCreate.InviteCode.Id, resGet.InviteCode.Id)
+
+	for _, includeExpired := range []bool{false, true} {
+		resGetList, err := client.ListInviteCodes(ctx, &rfpb.ListInviteCodeReq{ IncludeExpired: includeExpired })
+		if err != nil { tearDBDown(t); t.Fatal(err) }
+
+		cntInviteCodes := 0
+		for {
+			_, err := resGetList.Recv()
+			if err == io.EOF { break }
+			if err != nil { tearDBDown(t); t.Fatal(err) }
+			cntInviteCodes++
+		}
+		assert.Equal(t, includeExpired, cntInviteCodes == 1)
+	}
+
+	tearDBDown(t)
+}
+
 func TestRegisterUserInviteCodeServiceServer_DeleteInviteCode(t *testing.T) {
 
 	tearDBUp()
@@ -265,9 +409,9 @@ func TestRegisterUserInviteCodeServiceServer_DeleteInviteCode(t *testing.T) {
 	client := rfpb.NewUserInviteCodeServiceClient(conn)
 
 	MetaInviteCode := _genUserInviteCodeULID()
-	MetaInviteRole := "bad-ninja"
+	MetaInviteRole := "viewer"
 	tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now())
-	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now())
+	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
 	inviteCodeOrigin := rfpb.UserInviteCode{
 		MetaCode:       MetaInviteCode,
 		MetaForAppRole: MetaInviteRole,
@@ -303,9 +447,9 @@ func TestRegisterUserInviteCodeServiceServer_ListInviteCodes(t *testing.T) {
 	cntInviteCodes := 0
 	for i := 0 ; i < maxInviteCodes ; i++ {
 		MetaInviteCode := _genUserInviteCodeULID()
-		MetaInviteRole := "twin-ninja"
+		MetaInviteRole := "director"
 		tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now())
-		tsMetaValidTo, _ := ptypes.TimestampProto(time.Now())
+		tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
 		inviteCodeOrigin := rfpb.UserInviteCode{
 			MetaCode:       MetaInviteCode,
 			MetaForAppRole: MetaInviteRole,
@@ -354,10 +498,10 @@ func TestRegisterUserInviteCodeServiceServer_ListInviteCodesNilOnDeleted(t *test
 	cntInviteCodes := 0
 	for i := 0 ; i < maxInviteCodes ; i++ {
 		MetaInviteCode := _genUserInviteCodeULID()
-		MetaInviteRole := "deleted"
+		MetaInviteRole := "viewer"
 		tsMetaDeletedAt, _ := ptypes.TimestampProto(time.Now())
 		tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now())
-		tsMetaValidTo, _ := ptypes.TimestampProto(time.Now())
+		tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
 		inviteCodeOrigin := rfpb.UserInviteCode{
 			MetaCode:       MetaInviteCode,
 			MetaForAppRole: MetaInviteRole,
@@ -411,7 +555,7 @@ func TestRegisterUserInviteCodeServiceServer_ListFilteredInviteCodesAdmin(t *tes
 		MetaInviteCode := _genUserInviteCodeULID()
 		MetaInviteRole := inviteCodesRole
 		tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now())
-		tsMetaValidTo, _ := ptypes.TimestampProto(time.Now())
+		tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
 		inviteCodeOrigin := rfpb.UserInviteCode{
 			MetaCode:       MetaInviteCode,
 			MetaForAppRole: MetaInviteRole,
@@ -447,7 +591,7 @@ func TestRegisterUserInviteCodeServiceServer_ListFilteredInviteCodesViewer(t *te
 		MetaInviteCode := _genUserInviteCodeULID()
 		MetaInviteRole := inviteCodesRole
 		tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now())
-		tsMetaValidTo, _ := ptypes.TimestampProto(time.Now())
+		tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
 		inviteCodeOrigin := rfpb.UserInviteCode{
 			MetaCode:       MetaInviteCode,
 			MetaForAppRole: MetaInviteRole,
@@ -476,7 +620,7 @@ func TestRegisterUserInviteCodeServiceServer_ListFilteredInviteCodesNilOnDeleted
 
 	client := rfpb.NewUserInviteCodeServiceClient(conn)
 
-	inviteCodesRole := "deleted"
+	inviteCodesRole := "viewer"
 	inviteCodesMax  := 3
 
 	for i := 0 ; i < inviteCodesMax ; i++ {
@@ -484,7 +628,7 @@ func TestRegisterUserInviteCodeServiceServer_ListFilteredInviteCodesNilOnDeleted
 		MetaInviteRole := inviteCodesRole
 		tsMetaDeletedAt, _ := ptypes.TimestampProto(time.Now())
 		tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now())
-		tsMetaValidTo, _ := ptypes.TimestampProto(time.Now())
+		tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
 		inviteCodeOrigin := rfpb.UserInviteCode{
 			MetaCode:       MetaInviteCode,
 			MetaForAppRole: MetaInviteRole,
@@ -517,10 +661,10 @@ func TestRegisterUserInviteCodeServiceServer_ListFilteredInviteCodesByGuid(t *te
 
 	inviteCodeGuid := _genUserInviteCodeULID()
 	tsMetaValidFrom, _ := ptypes.TimestampProto(time.Now())
-	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now())
+	tsMetaValidTo, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
 	inviteCodeOrigin := rfpb.UserInviteCode{
 		MetaCode:       inviteCodeGuid,
-		MetaForAppRole: "ninja",
+		MetaForAppRole: "teacher",
 		MetaValidFrom:  tsMetaValidFrom,
 		MetaValidTo:    tsMetaValidTo,
 		IsTest:         true,
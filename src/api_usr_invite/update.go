@@ -0,0 +1,174 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"context"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"strings"
+	"time"
+)
+
+var (
+	// inviteCodeMutablePaths are the update_mask paths of UpdateInviteCode, paths are relative to UserInviteCode
+	inviteCodeMutablePaths = []string{"meta_code", "meta_for_app_role", "meta_valid_from", "meta_valid_to", "is_test"}
+
+	// inviteCodeImmutablePaths are server controlled (or changed by dedicated RPCs like DeleteInviteCode)
+	inviteCodeImmutablePaths = []string{"id", "created_at", "updated_at", "deleted_at", "is_deleted", "is_fixture", "version"}
+)
+
+//
+// -- gRPC Update Stack 15/n :: field mask based partial updates
+//
+
+// inviteCodeUpdatePaths returns the normalized update paths of the request, without (or with an empty) mask
+// all populated mutable fields of the invite code are updated, so omitted fields are never wiped.
+func inviteCodeUpdatePaths(req *rfpb.UpdateInviteCodeReq) ([]string, error) {
+
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		var paths []string
+		inviteCode := req.GetInviteCode().ProtoReflect()
+		for _, path := range inviteCodeMutablePaths {
+			if inviteCode.Has(inviteCode.Descriptor().Fields().ByName(protoreflect.Name(path))) {
+				paths = append(paths, path)
+			}
+		}
+		if len(paths) == 0 {
+			return nil, _getUpdateMaskViolation("nothing to update, set update_mask or at least one mutable field")
+		}
+		return paths, nil
+	}
+
+	var violations validationErrors
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch {
+		case _isInviteCodePath(inviteCodeImmutablePaths, path):
+			violations = append(violations, _getUpdateMaskViolation("path [%s] is immutable", path)...)
+		case !_isInviteCodePath(inviteCodeMutablePaths, path):
+			violations = append(violations, _getUpdateMaskViolation("unknown path [%s], mutable paths: %s", path, strings.Join(inviteCodeMutablePaths, ", "))...)
+		}
+	}
+
+	if len(violations) > 0 {
+		return nil, violations
+	}
+
+	mask := &fieldmaskpb.FieldMask{Paths: req.GetUpdateMask().GetPaths()}
+	mask.Normalize()
+
+	return mask.GetPaths(), nil
+}
+
+// inviteCodeUpdate validates the listed paths of the invite code (FieldRules of UserInviteCode, listed fields are
+// required) and returns the corresponding update.
+func inviteCodeUpdate(inviteCode *rfpb.UserInviteCode, paths []string, now time.Time) (bson.M, error) {
+
+	if err := validateFields(inviteCode, "inviteCode.", paths); err != nil {
+		return nil, err
+	}
+
+	fields := bson.M{"updated_at": now}
+	for _, path := range paths {
+		switch path {
+		case "meta_code":
+			fields[path] = inviteCode.GetMetaCode()
+		case "meta_for_app_role":
+			fields[path] = inviteCode.GetMetaForAppRole()
+		case "meta_valid_from":
+			fields[path] = _getTimeFromProto(inviteCode.GetMetaValidFrom())
+		case "meta_valid_to":
+			fields[path] = _getTimeFromProto(inviteCode.GetMetaValidTo())
+		case "is_test":
+			fields[path] = inviteCode.GetIsTest()
+		}
+	}
+
+	update := bson.M{"$set": fields, "$inc": bson.M{"version": 1}}
+	if _isInviteCodePath(paths, "meta_valid_to") {
+		// a code with a new validity is notified (expiring soon, expired) again
+		update["$unset"] = _getExpiryResetUpdate()
+	}
+
+	return update, nil
+}
+
+// inviteCodeValidityGuard returns the filter keeping meta_valid_to after meta_valid_from if only one of both is
+// updated (the other one is stored), nil otherwise (both are checked by the field rules of the request).
+func inviteCodeValidityGuard(inviteCode *rfpb.UserInviteCode, paths []string) bson.M {
+
+	from, to := _isInviteCodePath(paths, "meta_valid_from"), _isInviteCodePath(paths, "meta_valid_to")
+	switch {
+	case to && !from:
+		return bson.M{"$expr": bson.M{"$gt": bson.A{_getTimeFromProto(inviteCode.GetMetaValidTo()), "$meta_valid_from"}}}
+	case from && !to:
+		return bson.M{"$expr": bson.M{"$gt": bson.A{"$meta_valid_to", _getTimeFromProto(inviteCode.GetMetaValidFrom())}}}
+	}
+
+	return nil
+}
+
+// inviteCodeValidityError reports an update rejected by the validity guard as field violation (INVALID_ARGUMENT),
+// nil if the code doesn't exist (anymore) or the guard holds (the update failed for another reason).
+func inviteCodeValidityError(opCtx context.Context, oid primitive.ObjectID, guard bson.M, paths []string, err error) error {
+
+	if guard == nil || !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil
+	}
+
+	filter := bson.M{"_id": oid, "is_deleted": false}
+	if n, err := mongoDbCurrent().collection.CountDocuments(opCtx, filter); err != nil || n == 0 {
+		return nil
+	}
+
+	filter["$expr"] = guard["$expr"]
+	if n, err := mongoDbCurrent().collection.CountDocuments(opCtx, filter); err != nil || n > 0 {
+		return nil
+	}
+
+	if _isInviteCodePath(paths, "meta_valid_to") {
+		return validationErrors{{Field: "inviteCode.meta_valid_to", Description: "must be after the stored meta_valid_from"}}
+	}
+
+	return validationErrors{{Field: "inviteCode.meta_valid_from", Description: "must be before the stored meta_valid_to"}}
+}
+
+//
+// -- sidekick stack for update helper methods
+//
+
+func _isInviteCodePath(paths []string, path string) bool {
+
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+func _getUpdateMaskViolation(description string, args ...interface{}) validationErrors {
+	return validationErrors{{Field: "update_mask", Description: fmt.Sprintf(description, args...)}}
+}
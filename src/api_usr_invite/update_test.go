@@ -0,0 +1,121 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+//
+// -- core test methods :: field mask updates
+//
+
+func TestUpdateMask_OnlyListedPaths(t *testing.T) {
+
+	now := time.Now()
+	validTo := now.Add(time.Hour)
+	req := &rfpb.UpdateInviteCodeReq{
+		InviteCode: &rfpb.UserInviteCode{MetaCode: "ignored", MetaValidTo: timestamppb.New(validTo)},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"meta_valid_to", "meta_valid_to"}},
+	}
+
+	paths, err := inviteCodeUpdatePaths(req)
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, []string{"meta_valid_to"}, paths)
+
+	update, err := inviteCodeUpdate(req.GetInviteCode(), paths, now)
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, bson.M{"$set": bson.M{"meta_valid_to": validTo.UTC(), "updated_at": now}, "$inc": bson.M{"version": 1},
+		"$unset": bson.M{"expiring_notified_at": "", "expired_notified_at": ""}}, update)
+}
+
+func TestUpdateMask_ImmutableAndUnknownPaths(t *testing.T) {
+
+	_, err := inviteCodeUpdatePaths(&rfpb.UpdateInviteCodeReq{
+		InviteCode: &rfpb.UserInviteCode{},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"id", "created_at", "meta_code", "nope"}},
+	})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "path [id] is immutable")
+	assert.Contains(t, err.Error(), "path [created_at] is immutable")
+	assert.Contains(t, err.Error(), "unknown path [nope]")
+	assert.NotContains(t, err.Error(), "[meta_code]")
+}
+
+func TestUpdateMask_ImpliedPaths(t *testing.T) {
+
+	// without a mask only populated mutable fields are updated, immutable fields are ignored
+	paths, err := inviteCodeUpdatePaths(&rfpb.UpdateInviteCodeReq{InviteCode: &rfpb.UserInviteCode{
+		Id: "5f5a0f3e1c9d440000a1b2c3", MetaCode: "code", CreatedAt: timestamppb.Now(), MetaValidFrom: timestamppb.Now(),
+	}})
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, []string{"meta_code", "meta_valid_from"}, paths)
+
+	_, err = inviteCodeUpdatePaths(&rfpb.UpdateInviteCodeReq{InviteCode: &rfpb.UserInviteCode{IsDeleted: true}})
+	assert.Error(t, err)
+}
+
+func TestUpdateMask_Validation(t *testing.T) {
+
+	now := time.Now()
+	_, err := inviteCodeUpdate(&rfpb.UserInviteCode{
+		MetaValidFrom: timestamppb.New(now),
+		MetaValidTo:   timestamppb.New(now.Add(-time.Hour)),
+	}, []string{"meta_code", "meta_for_app_role", "meta_valid_from", "meta_valid_to"}, now)
+
+	assert.EqualError(t, err, "inviteCode.meta_code: must not be empty; inviteCode.meta_for_app_role: must not be empty; inviteCode.meta_valid_to: must be after inviteCode.meta_valid_from")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// a listed timestamp must be set, clearing the validity window is not supported
+	_, err = inviteCodeUpdate(&rfpb.UserInviteCode{}, []string{"meta_valid_to"}, now)
+	assert.EqualError(t, err, "inviteCode.meta_valid_to: must be set")
+
+	// the known roles are enforced on updates as well
+	_, err = inviteCodeUpdate(&rfpb.UserInviteCode{MetaForAppRole: "ninja"}, []string{"meta_for_app_role"}, now)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "inviteCode.meta_for_app_role: unknown role [ninja]")
+
+	// unlisted fields are neither validated nor set
+	update, err := inviteCodeUpdate(&rfpb.UserInviteCode{IsTest: true}, []string{"is_test"}, now)
+	if err != nil { t.Fatal(err) }
+	assert.Equal(t, bson.M{"$set": bson.M{"is_test": true, "updated_at": now}, "$inc": bson.M{"version": 1}}, update)
+}
+
+func TestUpdateMask_ValidityGuard(t *testing.T) {
+
+	validFrom, validTo := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)
+	inviteCode := &rfpb.UserInviteCode{MetaValidFrom: timestamppb.New(validFrom), MetaValidTo: timestamppb.New(validTo)}
+
+	// one side is compared with the stored other side, both sides are checked by the field rules
+	assert.Equal(t, bson.M{"$expr": bson.M{"$gt": bson.A{validTo, "$meta_valid_from"}}}, inviteCodeValidityGuard(inviteCode, []string{"meta_valid_to"}))
+	assert.Equal(t, bson.M{"$expr": bson.M{"$gt": bson.A{"$meta_valid_to", validFrom}}}, inviteCodeValidityGuard(inviteCode, []string{"is_test", "meta_valid_from"}))
+	assert.Nil(t, inviteCodeValidityGuard(inviteCode, []string{"meta_valid_from", "meta_valid_to"}))
+	assert.Nil(t, inviteCodeValidityGuard(inviteCode, []string{"meta_code"}))
+	assert.Nil(t, inviteCodeValidityError(ctx, primitive.NewObjectID(), nil, []string{"meta_code"}, mongo.ErrNoDocuments))
+}
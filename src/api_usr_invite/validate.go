@@ -0,0 +1,223 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"context"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"unicode/utf8"
+)
+
+const metaProtoTimestampName = protoreflect.FullName("google.protobuf.Timestamp")
+
+// validationErrors are the field violations of a request, reported as INVALID_ARGUMENT with google.rpc.BadRequest
+// details (field paths use the proto field names, e.g. "inviteCode.meta_valid_to").
+type validationErrors []*errdetails.BadRequest_FieldViolation
+
+// validationServerStream validates every message received by a streaming handler
+type validationServerStream struct {
+	grpc.ServerStream
+}
+
+//
+// -- gRPC Validation Stack 17/n :: declarative request validation (FieldRules of rf_example.proto)
+//
+
+func (v validationErrors) Error() string {
+
+	var violations []string
+	for _, violation := range v {
+		violations = append(violations, fmt.Sprintf("%s: %s", violation.GetField(), violation.GetDescription()))
+	}
+
+	return strings.Join(violations, "; ")
+}
+
+func (v validationErrors) GRPCStatus() *status.Status {
+
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("Invalid request: %s", v.Error()))
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v}); err == nil {
+		return detailed
+	}
+
+	return st
+}
+
+// validateRequest checks all fields of msg (and its nested messages) against their FieldRules
+func validateRequest(msg proto.Message) error {
+	return _getValidationError(_validateMessage(msg.ProtoReflect(), "", nil))
+}
+
+// validateFields checks the listed (top-level) fields of msg only, listed fields are required. It is used for partial
+// updates, prefix is the field path of msg within the request (e.g. "inviteCode.").
+func validateFields(msg proto.Message, prefix string, paths []string) error {
+
+	listed := map[string]bool{}
+	for _, path := range paths {
+		listed[path] = true
+	}
+
+	return _getValidationError(_validateMessage(msg.ProtoReflect(), prefix, listed))
+}
+
+func validationUnaryInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	if msg, ok := req.(proto.Message); ok {
+		if err := validateRequest(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func validationStreamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validationServerStream{ServerStream: ss})
+}
+
+func (s *validationServerStream) RecvMsg(m interface{}) error {
+
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if msg, ok := m.(proto.Message); ok {
+		return validateRequest(msg)
+	}
+
+	return nil
+}
+
+//
+// -- sidekick stack for validation helper methods
+//
+
+// _validateMessage applies the rules of all (or the listed) fields, nested messages are validated unless skipped
+func _validateMessage(m protoreflect.Message, prefix string, listed map[string]bool) validationErrors {
+
+	var violations validationErrors
+	violate := func(field string, description string, args ...interface{}) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: prefix + field, Description: fmt.Sprintf(description, args...)})
+	}
+
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+
+		fd := fields.Get(i)
+		name := string(fd.Name())
+		if listed != nil && !listed[name] {
+			continue
+		}
+
+		rules := _getFieldRules(fd)
+		required := rules.GetRequired() || listed != nil
+		if !m.Has(fd) {
+			if required && fd.Kind() == protoreflect.StringKind {
+				violate(name, "must not be empty")
+			} else if required && fd.Kind() == protoreflect.MessageKind {
+				violate(name, "must be set")
+			}
+			continue
+		}
+
+		if fd.IsList() || fd.IsMap() {
+			continue
+		}
+
+		value := m.Get(fd)
+		switch fd.Kind() {
+		case protoreflect.StringKind:
+			s := value.String()
+			if rules.GetMaxLen() > 0 && utf8.RuneCountInString(s) > int(rules.GetMaxLen()) {
+				violate(name, "must not be longer than %d characters", rules.GetMaxLen())
+			}
+			if rules.GetObjectId() {
+				if _, err := primitive.ObjectIDFromHex(s); err != nil {
+					violate(name, "must be a valid object id")
+				}
+			}
+			if rules.GetKnownRole() && !_isKnownRole(s) {
+				violate(name, "unknown role [%s], known roles: %s", s, strings.Join(configCurrent().inviteCodeRoles(), ", "))
+			}
+		case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind, protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+			if rules.GetNonNegative() && value.Int() < 0 {
+				violate(name, "must not be negative")
+			}
+		case protoreflect.MessageKind:
+			if fd.Message().FullName() != metaProtoTimestampName {
+				if !rules.GetSkip() {
+					violations = append(violations, _validateMessage(value.Message(), prefix+name+".", nil)...)
+				}
+				continue
+			}
+			ts := value.Message().Interface().(*timestamppb.Timestamp)
+			if err := ts.CheckValid(); err != nil {
+				violate(name, "must be a valid timestamp")
+				continue
+			}
+			if after := fields.ByName(protoreflect.Name(rules.GetAfter())); after != nil && m.Has(after) && (listed == nil || listed[rules.GetAfter()]) {
+				if afterTs, ok := m.Get(after).Message().Interface().(*timestamppb.Timestamp); ok && afterTs.CheckValid() == nil && !ts.AsTime().After(afterTs.AsTime()) {
+					violate(name, "must be after %s", prefix+rules.GetAfter())
+				}
+			}
+		}
+	}
+
+	return violations
+}
+
+func _getFieldRules(fd protoreflect.FieldDescriptor) *rfpb.FieldRules {
+
+	if fd.Options() == nil || !proto.HasExtension(fd.Options(), rfpb.E_Rules) {
+		return nil
+	}
+
+	rules, _ := proto.GetExtension(fd.Options(), rfpb.E_Rules).(*rfpb.FieldRules)
+
+	return rules
+}
+
+func _getValidationError(violations validationErrors) error {
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return violations
+}
+
+func _isKnownRole(role string) bool {
+
+	for _, known := range configCurrent().inviteCodeRoles() {
+		if role == known {
+			return true
+		}
+	}
+
+	return false
+}
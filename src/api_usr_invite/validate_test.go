@@ -0,0 +1,159 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+// validateTestStream receives a single request message
+type validateTestStream struct {
+	grpc.ServerStream
+	req proto.Message
+}
+
+//
+// -- core test helper methods :: *.n
+//
+
+func (s *validateTestStream) RecvMsg(m interface{}) error {
+
+	proto.Merge(m.(proto.Message), s.req)
+
+	return nil
+}
+
+func validateTestInviteCode() *rfpb.UserInviteCode {
+
+	now := time.Now()
+
+	return &rfpb.UserInviteCode{
+		MetaCode:       "code",
+		MetaForAppRole: "teacher",
+		MetaValidFrom:  timestamppb.New(now),
+		MetaValidTo:    timestamppb.New(now.Add(time.Hour)),
+	}
+}
+
+// validateTestViolations returns the field violations (field -> description) of the BadRequest details of err
+func validateTestViolations(t *testing.T, err error) map[string]string {
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	violations := map[string]string{}
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				violations[violation.GetField()] = violation.GetDescription()
+			}
+		}
+	}
+
+	return violations
+}
+
+//
+// -- core test methods :: request validation
+//
+
+func TestValidation_CreateInviteCode(t *testing.T) {
+
+	assert.NoError(t, validateRequest(&rfpb.CreateInviteCodeReq{InviteCode: validateTestInviteCode()}))
+
+	err := validateRequest(&rfpb.CreateInviteCodeReq{})
+	assert.Equal(t, map[string]string{"inviteCode": "must be set"}, validateTestViolations(t, err))
+
+	err = validateRequest(&rfpb.CreateInviteCodeReq{InviteCode: &rfpb.UserInviteCode{}})
+	assert.Equal(t, map[string]string{
+		"inviteCode.meta_code":         "must not be empty",
+		"inviteCode.meta_for_app_role": "must not be empty",
+		"inviteCode.meta_valid_from":   "must be set",
+		"inviteCode.meta_valid_to":     "must be set",
+	}, validateTestViolations(t, err))
+
+	inviteCode := validateTestInviteCode()
+	inviteCode.Id, inviteCode.MetaForAppRole = "not-an-object-id", "ninja"
+	inviteCode.MetaValidTo = timestamppb.New(inviteCode.GetMetaValidFrom().AsTime().Add(-time.Second))
+	violations := validateTestViolations(t, validateRequest(&rfpb.CreateInviteCodeReq{InviteCode: inviteCode}))
+	assert.Len(t, violations, 3)
+	assert.Equal(t, "must be a valid object id", violations["inviteCode.id"])
+	assert.Contains(t, violations["inviteCode.meta_for_app_role"], "unknown role [ninja]")
+	assert.Equal(t, "must be after inviteCode.meta_valid_from", violations["inviteCode.meta_valid_to"])
+}
+
+func TestValidation_Requests(t *testing.T) {
+
+	assert.NoError(t, validateRequest(&rfpb.GetInviteCodeReq{Id: "5f5a0f3e1c9d440000a1b2c3"}))
+	assert.Equal(t, map[string]string{"id": "must not be empty"}, validateTestViolations(t, validateRequest(&rfpb.GetInviteCodeReq{})))
+
+	err := validateRequest(&rfpb.DeleteInviteCodeReq{Id: "5f5a0f3e1c9d440000a1b2c3", ExpectedVersion: -1})
+	assert.Equal(t, map[string]string{"expected_version": "must not be negative"}, validateTestViolations(t, err))
+
+	// partial updates are validated by their paths, not by the rules of the (nested) invite code
+	assert.NoError(t, validateRequest(&rfpb.UpdateInviteCodeReq{InviteCode: &rfpb.UserInviteCode{MetaValidTo: timestamppb.Now()}}))
+
+	// messages without rules are always valid
+	assert.NoError(t, validateRequest(&rfpb.ListInviteCodeReq{}))
+}
+
+func TestValidation_Interceptors(t *testing.T) {
+
+	called := false
+	handler := func(context.Context, interface{}) (interface{}, error) { called = true; return nil, nil }
+
+	_, err := validationUnaryInterceptor(ctx, &rfpb.CreateInviteCodeReq{}, &grpc.UnaryServerInfo{}, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.False(t, called)
+
+	_, err = validationUnaryInterceptor(ctx, &rfpb.CreateInviteCodeReq{InviteCode: validateTestInviteCode()}, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.True(t, called)
+
+	// streaming handlers receive the validation error from RecvMsg
+	err = validationStreamInterceptor(nil, &validateTestStream{req: &rfpb.ListFilteredInviteCodeReq{}}, &grpc.StreamServerInfo{},
+		func(_ interface{}, ss grpc.ServerStream) error {
+			return ss.RecvMsg(&rfpb.ListFilteredInviteCodeReq{})
+		})
+	assert.NoError(t, err)
+
+	err = validationStreamInterceptor(nil, &validateTestStream{req: &rfpb.DeleteInviteCodeReq{}}, &grpc.StreamServerInfo{},
+		func(_ interface{}, ss grpc.ServerStream) error {
+			return ss.RecvMsg(&rfpb.DeleteInviteCodeReq{})
+		})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestValidation_KnownRoles(t *testing.T) {
+
+	cfg := &Config{InviteCodeRoles: " admin, ,teacher,"}
+	assert.Equal(t, []string{"admin", "teacher"}, cfg.inviteCodeRoles())
+
+	cfg.InviteCodeRoles = " , "
+	assert.Empty(t, cfg.inviteCodeRoles())
+}
@@ -0,0 +1,444 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	metaWatchModeAuto         = "auto"
+	metaWatchModeChangeStream = "change-stream"
+	metaWatchModePoll         = "poll"
+
+	metaWatchTokenChangeStream = "cs:"
+	metaWatchTokenPoll         = "poll:"
+
+	// polling skips the most recent changes, writes of other instances (clock skew) may still be in flight
+	metaWatchPollSettle = time.Second
+)
+
+var (
+	watchModes = []string{metaWatchModeAuto, metaWatchModeChangeStream, metaWatchModePoll}
+
+	// mongodb error codes of standalone servers (no change streams) and of resume tokens no longer in the oplog
+	mongoDbChangeStreamUnsupportedCodes = []int32{40573}
+	mongoDbChangeStreamHistoryLostCodes = []int32{260, 280, 286}
+
+	// watchChangeStreamUnsupported is set once mongodb rejected a change stream (auto mode falls back to polling)
+	watchChangeStreamUnsupported int32
+
+	errChangeStreamUnsupported = errors.New("change streams are not supported by mongodb (replica set required)")
+)
+
+// inviteCodeChange is a single change delivered by a watcher
+type inviteCodeChange struct {
+	Type        rfpb.WatchInviteCodesRes_EventType
+	InviteCode  *UserInviteCode
+	ResumeToken string
+	OccurredAt  time.Time
+}
+
+// inviteCodeWatcher sends all changes after the resume token (empty = from now on) until ctx is done
+type inviteCodeWatcher interface {
+	watch(ctx context.Context, role string, resumeToken string, send func(inviteCodeChange) error) error
+}
+
+// changeStreamWatcher watches a mongodb change stream (replica sets only)
+type changeStreamWatcher struct {
+	collection func() *mongo.Collection
+}
+
+// autoWatcher uses change streams and falls back to polling if mongodb doesn't support them
+type autoWatcher struct {
+	changeStream inviteCodeWatcher
+	poll         inviteCodeWatcher
+}
+
+// pollingWatcher queries the changes of an interval (created_at, updated_at, deleted_at) periodically, used for
+// standalone mongodb servers and test stores
+type pollingWatcher struct {
+	interval time.Duration
+	now      func() time.Time
+	poll     func(ctx context.Context, role string, since time.Time, until time.Time) ([]UserInviteCode, error)
+}
+
+// changeStreamEvent is the subset of a change event used to classify the change
+type changeStreamEvent struct {
+	ID                bson.Raw            `bson:"_id"`
+	OperationType     string              `bson:"operationType"`
+	ClusterTime       primitive.Timestamp `bson:"clusterTime"`
+	FullDocument      *UserInviteCode     `bson:"fullDocument"`
+	DocumentKey       struct {
+		ID primitive.ObjectID `bson:"_id"`
+	} `bson:"documentKey"`
+	UpdateDescription struct {
+		UpdatedFields bson.M   `bson:"updatedFields"`
+		RemovedFields []string `bson:"removedFields"`
+	} `bson:"updateDescription"`
+}
+
+//
+// -- gRPC Watch Stack 21/n :: invite code change streams (polling fallback)
+//
+
+// inviteCodeWatch streams the changes of the request to send, the stream ends with OK once the service drains
+func inviteCodeWatch(ctx context.Context, req *rfpb.WatchInviteCodesReq, watcher inviteCodeWatcher, send func(*rfpb.WatchInviteCodesRes) error) error {
+
+	watchCtx, cancel := mongoDbOperationContext(ctx, 0)
+	defer cancel()
+
+	go func() {
+		select {
+		case <-runtimeDrainStarted():
+			cancel()
+		case <-watchCtx.Done():
+		}
+	}()
+
+	log.Infof("%s: WatchInviteCodes: watch started (role=%s, resume=%v)",metaServiceName,req.GetMetaForAppRole(),req.GetResumeToken() != "")
+
+	err := watcher.watch(watchCtx, req.GetMetaForAppRole(), req.GetResumeToken(), func(change inviteCodeChange) error {
+		return send(&rfpb.WatchInviteCodesRes{
+			Type:        change.Type,
+			InviteCode:  inviteCodeToProto(change.InviteCode),
+			ResumeToken: change.ResumeToken,
+			OccurredAt:  timestamppb.New(change.OccurredAt),
+		})
+	})
+
+	// clients resume on another instance using the token of the last event
+	if runtimeIsDraining() || runtimeIsShuttingDown() {
+		log.Infof("%s: WatchInviteCodes: watch ended by drain",metaServiceName)
+		return nil
+	}
+
+	if errors.Is(err, errChangeStreamUnsupported) {
+		return status.Errorf(codes.FailedPrecondition, "%v, use INVITE_CODE_WATCH_MODE=%s", err, metaWatchModePoll)
+	}
+
+	if _, ok := status.FromError(err); ok || err == nil {
+		return err
+	}
+
+	return mongoDbDomainError(watchCtx, err, "")
+}
+
+// newInviteCodeWatcher returns the watcher of the configured mode (INVITE_CODE_WATCH_MODE)
+func newInviteCodeWatcher(cfg *Config) inviteCodeWatcher {
+
+	changeStream := &changeStreamWatcher{collection: func() *mongo.Collection { return mongoDbCurrent().collection }}
+	poll := &pollingWatcher{interval: cfg.InviteCodeWatchPollInterval, now: time.Now, poll: mongoDbPollInviteCodes}
+
+	switch cfg.InviteCodeWatchMode {
+	case metaWatchModeChangeStream:
+		return changeStream
+	case metaWatchModePoll:
+		return poll
+	}
+
+	return &autoWatcher{changeStream: changeStream, poll: poll}
+}
+
+func (w *autoWatcher) watch(ctx context.Context, role string, resumeToken string, send func(inviteCodeChange) error) error {
+
+	if atomic.LoadInt32(&watchChangeStreamUnsupported) == 0 && !strings.HasPrefix(resumeToken, metaWatchTokenPoll) {
+		if err := w.changeStream.watch(ctx, role, resumeToken, send); !errors.Is(err, errChangeStreamUnsupported) {
+			return err
+		}
+	}
+
+	return w.poll.watch(ctx, role, resumeToken, send)
+}
+
+func (w *changeStreamWatcher) watch(ctx context.Context, role string, resumeToken string, send func(inviteCodeChange) error) error {
+
+	streamOptions := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if resumeToken != "" {
+		token, err := _parseChangeStreamToken(resumeToken)
+		if err != nil {
+			return err
+		}
+		streamOptions.SetResumeAfter(token)
+	}
+
+	stream, err := w.collection().Watch(ctx, _getChangeStreamPipeline(role), streamOptions)
+	if err != nil {
+		return _getChangeStreamError(err)
+	}
+	defer stream.Close(context.Background())
+
+	for stream.Next(ctx) {
+		event := changeStreamEvent{}
+		if err := stream.Decode(&event); err != nil {
+			return fmt.Errorf("unable to decode change event: %v", err)
+		}
+
+		change, ok := _getChangeStreamChange(&event)
+		if !ok {
+			continue
+		}
+		if err := send(change); err != nil {
+			return err
+		}
+	}
+
+	return _getChangeStreamError(stream.Err())
+}
+
+func (w *pollingWatcher) watch(ctx context.Context, role string, resumeToken string, send func(inviteCodeChange) error) error {
+
+	since := w.now().Add(-metaWatchPollSettle)
+	if resumeToken != "" {
+		var err error
+		if since, err = _parsePollToken(resumeToken); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		until := w.now().Add(-metaWatchPollSettle)
+		if until.After(since) {
+			changed, err := w.poll(ctx, role, since, until)
+			if err != nil {
+				return err
+			}
+
+			for _, change := range _getPollChanges(changed, since) {
+				if err := send(change); err != nil {
+					return err
+				}
+			}
+			since = until
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// mongoDbPollInviteCodes returns the invite codes (of role) changed within (since, until]
+func mongoDbPollInviteCodes(ctx context.Context, role string, since time.Time, until time.Time) ([]UserInviteCode, error) {
+
+	window := bson.M{"$gt": since, "$lte": until}
+	filter := bson.M{"$or": bson.A{bson.M{"created_at": window}, bson.M{"updated_at": window}, bson.M{"deleted_at": window}}}
+	if role != "" {
+		filter["meta_for_app_role"] = role
+	}
+
+	cursor, err := mongoDbCurrent().collection.Find(ctx, filter, options.Find().SetBatchSize(int32(configCurrent().MongoDbListBatchSize)))
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []UserInviteCode
+	if err := cursor.All(ctx, &changed); err != nil {
+		return nil, err
+	}
+
+	return changed, nil
+}
+
+// _validateWatch returns all watch configuration errors
+func (c *Config) _validateWatch() []string {
+
+	var errs []string
+	if !_isMongoDbOption(watchModes, c.InviteCodeWatchMode) {
+		errs = append(errs, fmt.Sprintf("INVITE_CODE_WATCH_MODE: must be one of [%s], got %s", strings.Join(watchModes, ", "), c.InviteCodeWatchMode))
+	}
+
+	if c.InviteCodeWatchPollInterval <= 0 {
+		errs = append(errs, fmt.Sprintf("INVITE_CODE_WATCH_POLL_INTERVAL: must be positive, got %v", c.InviteCodeWatchPollInterval))
+	}
+
+	return errs
+}
+
+//
+// -- sidekick stack for watch helper methods
+//
+
+func _getChangeStreamPipeline(role string) mongo.Pipeline {
+
+	match := bson.D{{Key: "operationType", Value: bson.M{"$in": bson.A{"insert", "update", "replace", "delete"}}}}
+	if role != "" {
+		// deleted documents have no full document, role filtered watches only see (soft) deletes by is_deleted
+		match = append(match, bson.E{Key: "fullDocument.meta_for_app_role", Value: role})
+	}
+
+	return mongo.Pipeline{{{Key: "$match", Value: match}}}
+}
+
+// _getChangeStreamChange classifies a change event, updates of expiry notification marks only are skipped
+func _getChangeStreamChange(event *changeStreamEvent) (inviteCodeChange, bool) {
+
+	change := inviteCodeChange{
+		InviteCode:  event.FullDocument,
+		ResumeToken: metaWatchTokenChangeStream + base64.RawURLEncoding.EncodeToString(event.ID),
+		OccurredAt:  time.Unix(int64(event.ClusterTime.T), 0).UTC(),
+	}
+
+	switch event.OperationType {
+	case "insert":
+		change.Type = rfpb.WatchInviteCodesRes_CREATED
+	case "delete":
+		change.Type, change.InviteCode = rfpb.WatchInviteCodesRes_DELETED, &UserInviteCode{ID: event.DocumentKey.ID}
+	case "update", "replace":
+		if _isExpiryOnlyUpdate(event.UpdateDescription.UpdatedFields, event.UpdateDescription.RemovedFields) {
+			return change, false
+		}
+		change.Type = rfpb.WatchInviteCodesRes_UPDATED
+		if deleted, _ := event.UpdateDescription.UpdatedFields["is_deleted"].(bool); deleted || (event.OperationType == "replace" && event.FullDocument != nil && event.FullDocument.IsDeleted) {
+			change.Type = rfpb.WatchInviteCodesRes_DELETED
+		}
+	default:
+		return change, false
+	}
+
+	// updated documents removed before the lookup have no full document
+	if change.InviteCode == nil {
+		change.InviteCode = &UserInviteCode{ID: event.DocumentKey.ID}
+	}
+
+	return change, true
+}
+
+// _getPollChanges classifies the changed codes by their latest timestamp and orders them by it
+func _getPollChanges(changed []UserInviteCode, since time.Time) []inviteCodeChange {
+
+	var changes []inviteCodeChange
+	for i := range changed {
+		inviteCode := &changed[i]
+		change := inviteCodeChange{Type: rfpb.WatchInviteCodesRes_UPDATED, InviteCode: inviteCode, OccurredAt: inviteCode.CreatedAt}
+		if inviteCode.UpdatedAt.After(change.OccurredAt) {
+			change.OccurredAt = inviteCode.UpdatedAt
+		}
+
+		switch {
+		case inviteCode.IsDeleted && !inviteCode.DeletedAt.Before(change.OccurredAt):
+			change.Type, change.OccurredAt = rfpb.WatchInviteCodesRes_DELETED, inviteCode.DeletedAt
+		case inviteCode.CreatedAt.After(since) && inviteCode.CreatedAt.Equal(change.OccurredAt):
+			change.Type = rfpb.WatchInviteCodesRes_CREATED
+		}
+
+		change.ResumeToken = metaWatchTokenPoll + change.OccurredAt.UTC().Format(time.RFC3339Nano)
+		changes = append(changes, change)
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].OccurredAt.Before(changes[j].OccurredAt) })
+
+	return changes
+}
+
+func _isExpiryOnlyUpdate(updated bson.M, removed []string) bool {
+
+	fields := removed
+	for field := range updated {
+		fields = append(fields, field)
+	}
+
+	notified := map[string]bool{}
+	for _, field := range expiryNotifiedFields {
+		notified[field] = true
+	}
+
+	for _, field := range fields {
+		if !notified[field] {
+			return false
+		}
+	}
+
+	return len(fields) > 0
+}
+
+func _parseChangeStreamToken(token string) (bson.Raw, error) {
+
+	if !strings.HasPrefix(token, metaWatchTokenChangeStream) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid resume token: not a change stream token (watch mode changed?)")
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(token, metaWatchTokenChangeStream))
+	if err == nil {
+		err = bson.Raw(raw).Validate()
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid resume token: %v", err)
+	}
+
+	return raw, nil
+}
+
+func _parsePollToken(token string) (time.Time, error) {
+
+	if !strings.HasPrefix(token, metaWatchTokenPoll) {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "Invalid resume token: not a polling token (watch mode changed?)")
+	}
+
+	since, err := time.Parse(time.RFC3339Nano, strings.TrimPrefix(token, metaWatchTokenPoll))
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "Invalid resume token: %v", err)
+	}
+
+	return since, nil
+}
+
+func _getChangeStreamError(err error) error {
+
+	var cmdErr mongo.CommandError
+	if err == nil || !errors.As(err, &cmdErr) {
+		return err
+	}
+
+	for _, code := range mongoDbChangeStreamUnsupportedCodes {
+		if cmdErr.Code == code {
+			if atomic.CompareAndSwapInt32(&watchChangeStreamUnsupported, 0, 1) {
+				log.Warnf("%s: WatchInviteCodes: change streams not supported (standalone mongodb?): %v",metaServiceName,err)
+			}
+			return errChangeStreamUnsupported
+		}
+	}
+
+	for _, code := range mongoDbChangeStreamHistoryLostCodes {
+		if cmdErr.Code == code {
+			return status.Errorf(codes.OutOfRange, "Resume token is no longer available, please list the invite codes again")
+		}
+	}
+
+	return err
+}
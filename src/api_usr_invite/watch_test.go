@@ -0,0 +1,233 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+// watchTestWatcher sends changes and blocks until ctx is done, or fails with err
+type watchTestWatcher struct {
+	changes []inviteCodeChange
+	err     error
+	tokens  []string
+}
+
+//
+// -- core test helper methods :: *.n
+//
+
+func (w *watchTestWatcher) watch(ctx context.Context, _ string, resumeToken string, send func(inviteCodeChange) error) error {
+
+	w.tokens = append(w.tokens, resumeToken)
+	if w.err != nil {
+		return w.err
+	}
+
+	for _, change := range w.changes {
+		if err := send(change); err != nil {
+			return err
+		}
+	}
+	<-ctx.Done()
+
+	return nil
+}
+
+// watchTestPollingWatcher polls the given codes (filtered by role and window) every 10ms
+func watchTestPollingWatcher(inviteCodes []UserInviteCode) *pollingWatcher {
+
+	return &pollingWatcher{interval: 10 * time.Millisecond, now: time.Now, poll: func(_ context.Context, role string, since time.Time, until time.Time) ([]UserInviteCode, error) {
+		var changed []UserInviteCode
+		for _, inviteCode := range inviteCodes {
+			latest := inviteCode.CreatedAt
+			for _, at := range []time.Time{inviteCode.UpdatedAt, inviteCode.DeletedAt} {
+				if at.After(latest) {
+					latest = at
+				}
+			}
+			if (role == "" || inviteCode.MetaForAppRole == role) && latest.After(since) && !latest.After(until) {
+				changed = append(changed, inviteCode)
+			}
+		}
+		return changed, nil
+	}}
+}
+
+// watchTestCollect watches until n changes have been received
+func watchTestCollect(t *testing.T, watcher inviteCodeWatcher, role string, resumeToken string, n int) []inviteCodeChange {
+
+	watchCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var changes []inviteCodeChange
+	err := watcher.watch(watchCtx, role, resumeToken, func(change inviteCodeChange) error {
+		if changes = append(changes, change); len(changes) == n {
+			cancel()
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, changes, n)
+
+	return changes
+}
+
+//
+// -- core test methods :: invite code watch
+//
+
+func TestWatch_ChangeStreamEvents(t *testing.T) {
+
+	id := primitive.NewObjectID()
+	token, err := bson.Marshal(bson.M{"_data": "8263"})
+	if err != nil { t.Fatal(err) }
+
+	event := func(operationType string, updated bson.M, removed ...string) *changeStreamEvent {
+		event := &changeStreamEvent{ID: token, OperationType: operationType, ClusterTime: primitive.Timestamp{T: 1601510400}}
+		event.DocumentKey.ID = id
+		event.UpdateDescription.UpdatedFields, event.UpdateDescription.RemovedFields = updated, removed
+		if operationType != "delete" {
+			event.FullDocument = &UserInviteCode{ID: id, MetaCode: "code", IsDeleted: updated["is_deleted"] == true}
+		}
+		return event
+	}
+
+	for _, tc := range []struct {
+		event     *changeStreamEvent
+		eventType rfpb.WatchInviteCodesRes_EventType
+		skipped   bool
+	}{
+		{event("insert", nil), rfpb.WatchInviteCodesRes_CREATED, false},
+		{event("update", bson.M{"meta_valid_to": time.Now(), "version": 2}), rfpb.WatchInviteCodesRes_UPDATED, false},
+		{event("update", bson.M{"is_deleted": true, "deleted_at": time.Now()}), rfpb.WatchInviteCodesRes_DELETED, false},
+		{event("update", nil, "expired_notified_at"), 0, true},
+		{event("update", bson.M{"expiring_notified_at": time.Now()}), 0, true},
+		{event("replace", nil), rfpb.WatchInviteCodesRes_UPDATED, false},
+		{event("delete", nil), rfpb.WatchInviteCodesRes_DELETED, false},
+		{event("drop", nil), 0, true},
+	} {
+		change, ok := _getChangeStreamChange(tc.event)
+		assert.Equal(t, !tc.skipped, ok, tc.event.OperationType)
+		if tc.skipped {
+			continue
+		}
+		assert.Equal(t, tc.eventType, change.Type, tc.event.OperationType)
+		assert.Equal(t, id, change.InviteCode.ID)
+		assert.Equal(t, time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC), change.OccurredAt)
+
+		raw, err := _parseChangeStreamToken(change.ResumeToken)
+		if err != nil { t.Fatal(err) }
+		assert.Equal(t, "8263", raw.Lookup("_data").StringValue())
+	}
+
+	for _, token := range []string{"poll:2020-10-01T00:00:00Z", "cs:!!", "cs:" + "AAAA"} {
+		_, err := _parseChangeStreamToken(token)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), token)
+	}
+}
+
+func TestWatch_PollingWatcher(t *testing.T) {
+
+	base := time.Now().Add(-time.Minute)
+	inviteCodes := []UserInviteCode{
+		{ID: primitive.NewObjectID(), MetaCode: "created", MetaForAppRole: "teacher", CreatedAt: base.Add(time.Second)},
+		{ID: primitive.NewObjectID(), MetaCode: "deleted", MetaForAppRole: "teacher", CreatedAt: base.Add(-time.Hour), UpdatedAt: base.Add(2 * time.Second), IsDeleted: true, DeletedAt: base.Add(3 * time.Second)},
+		{ID: primitive.NewObjectID(), MetaCode: "updated", MetaForAppRole: "viewer", CreatedAt: base.Add(-time.Hour), UpdatedAt: base.Add(2 * time.Second)},
+		{ID: primitive.NewObjectID(), MetaCode: "old", MetaForAppRole: "teacher", CreatedAt: base.Add(-time.Hour)},
+	}
+	watcher := watchTestPollingWatcher(inviteCodes)
+
+	changes := watchTestCollect(t, watcher, "", metaWatchTokenPoll+base.Format(time.RFC3339Nano), 3)
+	assert.Equal(t, "created", changes[0].InviteCode.MetaCode)
+	assert.Equal(t, rfpb.WatchInviteCodesRes_CREATED, changes[0].Type)
+	assert.Equal(t, "updated", changes[1].InviteCode.MetaCode)
+	assert.Equal(t, rfpb.WatchInviteCodesRes_UPDATED, changes[1].Type)
+	assert.Equal(t, "deleted", changes[2].InviteCode.MetaCode)
+	assert.Equal(t, rfpb.WatchInviteCodesRes_DELETED, changes[2].Type)
+
+	// resuming after the first change skips it, the role filter applies as well
+	changes = watchTestCollect(t, watcher, "teacher", changes[0].ResumeToken, 1)
+	assert.Equal(t, "deleted", changes[0].InviteCode.MetaCode)
+
+	_, err := _parsePollToken("cs:AAAA")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestWatch_AutoFallback(t *testing.T) {
+
+	changeStream := &watchTestWatcher{err: errChangeStreamUnsupported}
+	poll := &watchTestWatcher{changes: []inviteCodeChange{{Type: rfpb.WatchInviteCodesRes_CREATED, InviteCode: &UserInviteCode{}}}}
+
+	changes := watchTestCollect(t, &autoWatcher{changeStream: changeStream, poll: poll}, "", "", 1)
+	assert.Equal(t, rfpb.WatchInviteCodesRes_CREATED, changes[0].Type)
+	assert.Len(t, changeStream.tokens, 1)
+	assert.Len(t, poll.tokens, 1)
+
+	// polling tokens are resumed by polling
+	watchTestCollect(t, &autoWatcher{changeStream: changeStream, poll: poll}, "", "poll:2020-10-01T00:00:00Z", 1)
+	assert.Len(t, changeStream.tokens, 1)
+
+	// without fallback the client is told to configure polling
+	err := inviteCodeWatch(ctx, &rfpb.WatchInviteCodesReq{}, changeStream, func(*rfpb.WatchInviteCodesRes) error { return nil })
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestWatch_EndsOnDrain(t *testing.T) {
+
+	runtimeTestReset()
+	defer runtimeTestReset()
+
+	sent := make(chan struct{})
+	watcher := &watchTestWatcher{changes: []inviteCodeChange{{Type: rfpb.WatchInviteCodesRes_CREATED, InviteCode: &UserInviteCode{}}}}
+	go func() {
+		<-sent
+		runtimeDrain(0)
+	}()
+
+	err := inviteCodeWatch(ctx, &rfpb.WatchInviteCodesReq{}, watcher, func(res *rfpb.WatchInviteCodesRes) error {
+		assert.Equal(t, rfpb.WatchInviteCodesRes_CREATED, res.GetType())
+		close(sent)
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// errors of the store are reported as domain errors while serving
+	runtimeTestReset()
+	err = inviteCodeWatch(ctx, &rfpb.WatchInviteCodesReq{}, &watchTestWatcher{err: mongo.ErrClientDisconnected}, func(*rfpb.WatchInviteCodesRes) error { return nil })
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestWatch_Config(t *testing.T) {
+
+	cfg := &Config{InviteCodeWatchMode: "push", InviteCodeWatchPollInterval: 0}
+	assert.Len(t, cfg._validateWatch(), 2)
+
+	cfg = &Config{InviteCodeWatchMode: metaWatchModePoll, InviteCodeWatchPollInterval: time.Second}
+	assert.Empty(t, cfg._validateWatch())
+	assert.IsType(t, &pollingWatcher{}, newInviteCodeWatcher(cfg))
+}
@@ -0,0 +1,634 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// the CORS defaults are taken 1:1 from the virtual host definition in src/sys_envoy/envoy.yaml,
+// extended by the request headers used by connect clients.
+const (
+	metaWebPathPrefix        = "/stub/usr-invite-codes"
+	metaWebCorsOrigin        = ".*"
+	metaWebCorsMaxAge        = "1728000"
+	metaWebCorsAllowMethods  = "GET, PUT, DELETE, POST, OPTIONS"
+	metaWebCorsAllowHeaders  = "keep-alive,user-agent,cache-control,content-type,content-transfer-encoding,custom-header-1,x-accept-content-transfer-encoding,x-accept-response-streaming,x-user-agent,x-grpc-web,grpc-timeout,connect-protocol-version,connect-timeout-ms,if-match,x-request-id"
+	metaWebCorsExposeHeaders = "custom-header-1,grpc-status,grpc-message,grpc-status-details-bin,grpc-status-details-text,etag,x-request-id"
+	metaWebMaxRequestSize    = 4 << 20
+)
+
+const (
+	webFrameTrailer   byte = 0x80
+	webFrameEndStream byte = 0x02
+	webFrameCompress  byte = 0x01
+)
+
+// webBridge serves native gRPC, gRPC-Web and the connect protocol on a single (h2c) listener, every
+// non-native call gets translated into a gRPC call and served by the wrapped grpc.Server itself.
+type webBridge struct {
+	grpcServer *grpc.Server
+	corsOrigin *regexp.Regexp
+}
+
+// webResponseWriter captures the gRPC response of the wrapped grpc.Server and hands over the
+// response headers and every complete length-prefixed message to the corresponding callbacks.
+type webResponseWriter struct {
+	header      http.Header
+	buffer      bytes.Buffer
+	wroteHeader bool
+	onHeader    func(http.Header)
+	onMessage   func(flags byte, msg []byte)
+}
+
+type webConnectError struct {
+	Code    string                  `json:"code"`
+	Message string                  `json:"message,omitempty"`
+	Details []webConnectErrorDetail `json:"details,omitempty"`
+}
+
+type webConnectErrorDetail struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type webConnectEndStream struct {
+	Error    *webConnectError    `json:"error,omitempty"`
+	Metadata map[string][]string `json:"metadata,omitempty"`
+}
+
+//
+// -- gRPC Web Stack 4/n :: gRPC-Web / Connect bridge
+//
+
+func newWebBridge(srv *grpc.Server, corsOrigin string) (*webBridge, error) {
+
+	if corsOrigin == "" {
+		corsOrigin = metaWebCorsOrigin
+	}
+
+	pattern, err := regexp.Compile(fmt.Sprintf("^(%s)$", corsOrigin))
+	if err != nil {
+		return nil, fmt.Errorf("invalid cors origin pattern [%s]: %v", corsOrigin, err)
+	}
+
+	return &webBridge{grpcServer: srv, corsOrigin: pattern}, nil
+}
+
+func (b *webBridge) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	// keep the route rewrite of our envoy setup, so the frontend works with or without the proxy
+	if strings.HasPrefix(r.URL.Path, metaWebPathPrefix) {
+		r.URL.Path = "/" + strings.TrimLeft(strings.TrimPrefix(r.URL.Path, metaWebPathPrefix), "/")
+	}
+
+	if b._handleCors(w, r) {
+		return
+	}
+
+	contentType := r.Header.Get("Content-Type")
+	switch {
+	case strings.HasPrefix(contentType, "application/grpc-web"):
+		b.serveGRPCWeb(w, r)
+	case strings.HasPrefix(contentType, "application/grpc") && r.ProtoMajor == 2:
+		b.grpcServer.ServeHTTP(w, r)
+	case r.Method == http.MethodPost && _isConnectContentType(contentType):
+		b.serveConnect(w, r)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported content-type [%s]", contentType), http.StatusUnsupportedMediaType)
+	}
+}
+
+func (b *webBridge) serveGRPCWeb(w http.ResponseWriter, r *http.Request) {
+
+	contentType := r.Header.Get("Content-Type")
+	isText := strings.HasPrefix(contentType, "application/grpc-web-text")
+	subType := strings.TrimPrefix(strings.TrimPrefix(contentType, "application/grpc-web-text"), "application/grpc-web")
+
+	var body io.Reader = r.Body
+	if isText {
+		body = base64.NewDecoder(base64.StdEncoding, r.Body)
+	}
+
+	flusher, _ := w.(http.Flusher)
+	writeFrame := func(flags byte, msg []byte) {
+		frame := _newWebFrame(flags, msg)
+		if isText {
+			frame = []byte(base64.StdEncoding.EncodeToString(frame))
+		}
+		_, _ = w.Write(frame)
+		if flusher != nil { flusher.Flush() }
+	}
+
+	rw := &webResponseWriter{
+		header: http.Header{},
+		onHeader: func(h http.Header) {
+			_copyWebHeader(w.Header(), h)
+			w.Header().Set("Content-Type", contentType)
+			w.WriteHeader(http.StatusOK)
+		},
+		onMessage: writeFrame,
+	}
+
+	b.grpcServer.ServeHTTP(rw, _newWebGRPCRequest(r, "application/grpc"+subType, body))
+	rw.WriteHeader(http.StatusOK)
+
+	// grpc-web transports the trailers as last (0x80 flagged) frame of the response body
+	trailer := &bytes.Buffer{}
+	for k, vv := range rw.trailer() {
+		for _, v := range vv {
+			_, _ = fmt.Fprintf(trailer, "%s: %s\r\n", strings.ToLower(k), v)
+		}
+	}
+
+	writeFrame(webFrameTrailer, trailer.Bytes())
+}
+
+func (b *webBridge) serveConnect(w http.ResponseWriter, r *http.Request) {
+
+	contentType := r.Header.Get("Content-Type")
+	isStream := strings.HasPrefix(contentType, "application/connect+")
+	isJSON := strings.HasSuffix(contentType, "json")
+
+	method, err := _getWebMethodDescriptor(r.URL.Path)
+	if err != nil {
+		_writeConnectError(w, isStream, http.Header{}, webConnectStatus(codes.Unimplemented, err.Error()))
+		return
+	}
+
+	encodingHeader := "Content-Encoding"
+	if isStream { encodingHeader = "Connect-Content-Encoding" }
+	if enc := r.Header.Get(encodingHeader); enc != "" && enc != "identity" {
+		_writeConnectError(w, isStream, http.Header{}, webConnectStatus(codes.Unimplemented, fmt.Sprintf("unsupported compression [%s]", enc)))
+		return
+	}
+
+	// one byte beyond the limit tells an oversized body apart from one of exactly the max. size
+	raw, err := ioutil.ReadAll(io.LimitReader(r.Body, metaWebMaxRequestSize+1))
+	if err != nil {
+		_writeConnectError(w, isStream, http.Header{}, webConnectStatus(codes.InvalidArgument, fmt.Sprintf("unable to read request: %v", err)))
+		return
+	}
+	if len(raw) > metaWebMaxRequestSize {
+		_writeConnectError(w, isStream, http.Header{}, webConnectStatus(codes.ResourceExhausted, fmt.Sprintf("request exceeds the max. size of %d bytes", metaWebMaxRequestSize)))
+		return
+	}
+
+	// unary connect calls send the plain message, streaming calls an enveloped one (same framing as gRPC)
+	messages := [][]byte{raw}
+	if isStream {
+		if messages, err = _splitWebFrames(raw); err != nil {
+			_writeConnectError(w, isStream, http.Header{}, webConnectStatus(codes.InvalidArgument, err.Error()))
+			return
+		}
+	}
+
+	grpcBody := &bytes.Buffer{}
+	for _, msg := range messages {
+		if isJSON {
+			if msg, err = _convertWebJSONToProto(method.Input(), msg); err != nil {
+				_writeConnectError(w, isStream, http.Header{}, webConnectStatus(codes.InvalidArgument, err.Error()))
+				return
+			}
+		}
+		grpcBody.Write(_newWebFrame(0, msg))
+	}
+
+	req := _newWebGRPCRequest(r, "application/grpc+proto", grpcBody)
+	if timeout := r.Header.Get("Connect-Timeout-Ms"); timeout != "" {
+		req.Header.Set("Grpc-Timeout", _getWebGRPCTimeout(timeout))
+	}
+
+	for k := range req.Header {
+		if strings.HasPrefix(strings.ToLower(k), "connect-") { req.Header.Del(k) }
+	}
+
+	flusher, _ := w.(http.Flusher)
+	responseHeader := http.Header{}
+	var responses [][]byte
+	var convertErr error
+
+	rw := &webResponseWriter{
+		header: http.Header{},
+		onHeader: func(h http.Header) {
+			_copyWebHeader(responseHeader, h)
+			if isStream {
+				_copyWebHeader(w.Header(), h)
+				w.Header().Set("Content-Type", contentType)
+				w.WriteHeader(http.StatusOK)
+			}
+		},
+		onMessage: func(_ byte, msg []byte) {
+			if isJSON && convertErr == nil {
+				msg, convertErr = _convertWebProtoToJSON(method.Output(), msg)
+			}
+			if !isStream {
+				responses = append(responses, msg)
+				return
+			}
+			_, _ = w.Write(_newWebFrame(0, msg))
+			if flusher != nil { flusher.Flush() }
+		},
+	}
+
+	b.grpcServer.ServeHTTP(rw, req)
+	rw.WriteHeader(http.StatusOK)
+
+	trailer := rw.trailer()
+	grpcStatus := _getWebStatusFromTrailer(trailer)
+	if convertErr != nil && grpcStatus.GetCode() == int32(codes.OK) {
+		grpcStatus = webConnectStatus(codes.Internal, convertErr.Error())
+	}
+
+	for _, k := range []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"} {
+		trailer.Del(k)
+	}
+
+	if isStream {
+		end := webConnectEndStream{Metadata: trailer}
+		if grpcStatus.GetCode() != int32(codes.OK) {
+			end.Error = _newWebConnectError(grpcStatus)
+		}
+		data, _ := json.Marshal(end)
+		_, _ = w.Write(_newWebFrame(webFrameEndStream, data))
+		return
+	}
+
+	for k, vv := range trailer {
+		for _, v := range vv { responseHeader.Add("Trailer-"+k, v) }
+	}
+
+	if grpcStatus.GetCode() == int32(codes.OK) && len(responses) != 1 {
+		grpcStatus = webConnectStatus(codes.Unimplemented, "unary connect call received none or multiple responses")
+	}
+
+	if grpcStatus.GetCode() != int32(codes.OK) {
+		_writeConnectError(w, false, responseHeader, grpcStatus)
+		return
+	}
+
+	_copyWebHeader(w.Header(), responseHeader)
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(responses[0])
+}
+
+//
+// -- sidekick stack for gRPC-Web / Connect helper methods
+//
+
+func (b *webBridge) _handleCors(w http.ResponseWriter, r *http.Request) bool {
+
+	origin := r.Header.Get("Origin")
+	isPreflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+	if origin == "" || !b.corsOrigin.MatchString(origin) {
+		if isPreflight {
+			w.WriteHeader(http.StatusForbidden)
+		}
+		return isPreflight
+	}
+
+	h := w.Header()
+	h.Set("Access-Control-Allow-Origin", origin)
+	h.Set("Access-Control-Expose-Headers", metaWebCorsExposeHeaders)
+	h.Add("Vary", "Origin")
+
+	if !isPreflight {
+		return false
+	}
+
+	h.Set("Access-Control-Allow-Methods", metaWebCorsAllowMethods)
+	h.Set("Access-Control-Allow-Headers", metaWebCorsAllowHeaders)
+	h.Set("Access-Control-Max-Age", metaWebCorsMaxAge)
+	w.WriteHeader(http.StatusNoContent)
+
+	return true
+}
+
+func (rw *webResponseWriter) Header() http.Header {
+	return rw.header
+}
+
+func (rw *webResponseWriter) WriteHeader(_ int) {
+
+	if rw.wroteHeader {
+		return
+	}
+
+	rw.wroteHeader = true
+	rw.onHeader(rw.header)
+}
+
+func (rw *webResponseWriter) Write(p []byte) (int, error) {
+
+	rw.WriteHeader(http.StatusOK)
+	rw.buffer.Write(p)
+
+	for rw.buffer.Len() >= 5 {
+		size := int(binary.BigEndian.Uint32(rw.buffer.Bytes()[1:5]))
+		if rw.buffer.Len() < 5+size {
+			break
+		}
+		frame := make([]byte, 5+size)
+		_, _ = rw.buffer.Read(frame)
+		rw.onMessage(frame[0], frame[5:])
+	}
+
+	return len(p), nil
+}
+
+func (rw *webResponseWriter) Flush() {
+	rw.WriteHeader(http.StatusOK)
+}
+
+// trailer returns the gRPC status and all trailing metadata, grpc.Server sets both as (pre-declared
+// or "Trailer:" prefixed) header values after the response headers have been sent.
+func (rw *webResponseWriter) trailer() http.Header {
+
+	trailer := http.Header{}
+	for k, vv := range rw.header {
+		switch {
+		case strings.HasPrefix(k, http.TrailerPrefix):
+			trailer[http.CanonicalHeaderKey(strings.TrimPrefix(k, http.TrailerPrefix))] = vv
+		case k == "Grpc-Status" || k == "Grpc-Message" || k == "Grpc-Status-Details-Bin":
+			trailer[k] = vv
+		}
+	}
+
+	return trailer
+}
+
+func webConnectStatus(code codes.Code, msg string) *status.Status {
+	return &status.Status{Code: int32(code), Message: msg}
+}
+
+func _isConnectContentType(contentType string) bool {
+
+	return strings.HasPrefix(contentType, "application/proto") ||
+		strings.HasPrefix(contentType, "application/json") ||
+		strings.HasPrefix(contentType, "application/connect+proto") ||
+		strings.HasPrefix(contentType, "application/connect+json")
+}
+
+func _newWebFrame(flags byte, msg []byte) []byte {
+
+	frame := make([]byte, 5+len(msg))
+	frame[0] = flags
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(msg)))
+	copy(frame[5:], msg)
+
+	return frame
+}
+
+func _splitWebFrames(data []byte) ([][]byte, error) {
+
+	var messages [][]byte
+	for len(data) > 0 {
+		if len(data) < 5 {
+			return nil, fmt.Errorf("incomplete envelope (%d bytes)", len(data))
+		}
+		if data[0]&webFrameCompress != 0 {
+			return nil, fmt.Errorf("compressed envelopes are not supported")
+		}
+		size := int(binary.BigEndian.Uint32(data[1:5]))
+		if len(data) < 5+size {
+			return nil, fmt.Errorf("incomplete envelope (expect %d bytes, got %d)", size, len(data)-5)
+		}
+		messages = append(messages, data[5:5+size])
+		data = data[5+size:]
+	}
+
+	return messages, nil
+}
+
+func _newWebGRPCRequest(r *http.Request, contentType string, body io.Reader) *http.Request {
+
+	req := r.Clone(r.Context())
+	req.Proto, req.ProtoMajor, req.ProtoMinor = "HTTP/2.0", 2, 0
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Del("Content-Length")
+	req.ContentLength = -1
+	req.Body = ioutil.NopCloser(body)
+
+	return req
+}
+
+func _copyWebHeader(dst http.Header, src http.Header) {
+
+	for k, vv := range src {
+		if k == "Content-Type" || k == "Trailer" || strings.HasPrefix(k, http.TrailerPrefix) {
+			continue
+		}
+		for _, v := range vv {
+			dst.Add(k, v)
+		}
+	}
+}
+
+func _getWebGRPCTimeout(timeoutMs string) string {
+
+	ms, err := strconv.ParseInt(timeoutMs, 10, 64)
+	if err != nil || ms < 0 {
+		return "0m"
+	}
+
+	// grpc-timeout allows 8 digits at most, fall back to seconds for large values
+	if ms > 99999999 {
+		return fmt.Sprintf("%dS", ms/1000)
+	}
+
+	return fmt.Sprintf("%dm", ms)
+}
+
+func _getWebMethodDescriptor(path string) (protoreflect.MethodDescriptor, error) {
+
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unknown procedure [%s]", path)
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(parts[0]))
+	if err != nil {
+		return nil, fmt.Errorf("unknown service [%s]", parts[0])
+	}
+
+	service, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("unknown service [%s]", parts[0])
+	}
+
+	method := service.Methods().ByName(protoreflect.Name(parts[1]))
+	if method == nil {
+		return nil, fmt.Errorf("unknown method [%s] in service [%s]", parts[1], parts[0])
+	}
+
+	return method, nil
+}
+
+func _convertWebJSONToProto(desc protoreflect.MessageDescriptor, data []byte) ([]byte, error) {
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, err
+	}
+
+	msg := mt.New().Interface()
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal json request: %v", err)
+	}
+
+	return proto.Marshal(msg)
+}
+
+func _convertWebProtoToJSON(desc protoreflect.MessageDescriptor, data []byte) ([]byte, error) {
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, err
+	}
+
+	msg := mt.New().Interface()
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+
+	return protojson.Marshal(msg)
+}
+
+func _getWebStatusFromTrailer(trailer http.Header) *status.Status {
+
+	code, err := strconv.Atoi(trailer.Get("Grpc-Status"))
+	if err != nil {
+		return webConnectStatus(codes.Unknown, "missing grpc-status in response trailer")
+	}
+
+	st := &status.Status{}
+	if details := trailer.Get("Grpc-Status-Details-Bin"); details != "" {
+		if raw, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(details, "=")); err == nil {
+			_ = proto.Unmarshal(raw, st)
+		}
+	}
+
+	st.Code = int32(code)
+	if msg, err := url.PathUnescape(trailer.Get("Grpc-Message")); err == nil {
+		st.Message = msg
+	} else {
+		st.Message = trailer.Get("Grpc-Message")
+	}
+
+	return st
+}
+
+func _newWebConnectError(st *status.Status) *webConnectError {
+
+	connectErr := &webConnectError{
+		Code:    _getWebConnectCode(codes.Code(st.GetCode())),
+		Message: st.GetMessage(),
+	}
+
+	for _, detail := range st.GetDetails() {
+		connectErr.Details = append(connectErr.Details, webConnectErrorDetail{
+			Type:  strings.TrimPrefix(detail.GetTypeUrl(), "type.googleapis.com/"),
+			Value: base64.RawStdEncoding.EncodeToString(detail.GetValue()),
+		})
+	}
+
+	return connectErr
+}
+
+func _writeConnectError(w http.ResponseWriter, isStream bool, header http.Header, st *status.Status) {
+
+	_copyWebHeader(w.Header(), header)
+	connectErr := _newWebConnectError(st)
+
+	if isStream {
+		data, _ := json.Marshal(webConnectEndStream{Error: connectErr})
+		w.Header().Set("Content-Type", "application/connect+json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(_newWebFrame(webFrameEndStream, data))
+		return
+	}
+
+	data, _ := json.Marshal(connectErr)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(_getWebConnectHTTPStatus(codes.Code(st.GetCode())))
+	_, _ = w.Write(data)
+}
+
+func _getWebConnectCode(code codes.Code) string {
+
+	// connect uses the snake_case variant of the gRPC code names (e.g. "NotFound" -> "not_found")
+	name := code.String()
+
+	var out strings.Builder
+	for i, c := range name {
+		if c >= 'A' && c <= 'Z' {
+			if i > 0 { out.WriteByte('_') }
+			c += 'a' - 'A'
+		}
+		out.WriteRune(c)
+	}
+
+	return out.String()
+}
+
+func _getWebConnectHTTPStatus(code codes.Code) int {
+
+	switch code {
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	}
+
+	return http.StatusInternalServerError
+}
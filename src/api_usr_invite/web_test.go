@@ -0,0 +1,178 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"bytes"
+	"encoding/json"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+//
+// -- core test helper methods :: *.n
+//
+
+func webTestServer(t *testing.T) *httptest.Server {
+
+	server := grpc.NewServer()
+	rfpb.RegisterUserInviteCodeServiceServer(server, &UserInviteCodeServiceServer{})
+
+	web, err := newWebBridge(server, "https://.*\\.relicfrog\\.com")
+	if err != nil { t.Fatal(err) }
+
+	return httptest.NewServer(h2c.NewHandler(web, &http2.Server{}))
+}
+
+//
+// -- core test methods :: gRPC-Web / Connect bridge
+//
+
+func TestWebBridge_NativeGRPC(t *testing.T) {
+
+	srv := webTestServer(t); defer srv.Close()
+
+	conn, err := grpc.DialContext(ctx, strings.TrimPrefix(srv.URL, "http://"), grpc.WithInsecure())
+	if err != nil { t.Fatal(err) }; defer conn.Close()
+
+	resGetV, err := rfpb.NewUserInviteCodeServiceClient(conn).GetVersion(ctx, &rfpb.VersionReq{})
+	if err != nil { t.Fatalf("GetVersion failed: %v", err) }
+
+	assert.Regexp(t, regexp.MustCompile("v([0-9]+.[0-9]+.[0-9]+)"), resGetV.Version)
+}
+
+func TestWebBridge_GRPCWeb(t *testing.T) {
+
+	srv := webTestServer(t); defer srv.Close()
+
+	reqBody, _ := proto.Marshal(&rfpb.VersionReq{})
+	res, err := http.Post(srv.URL+metaWebPathPrefix+"/aribor.UserInviteCodeService/GetVersion",
+		"application/grpc-web+proto", bytes.NewReader(_newWebFrame(0, reqBody)))
+	if err != nil { t.Fatal(err) }; defer res.Body.Close()
+
+	body, _ := ioutil.ReadAll(res.Body)
+	frames, err := _splitWebFrames(body)
+	if err != nil { t.Fatal(err) }
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "application/grpc-web+proto", res.Header.Get("Content-Type"))
+	assert.Len(t, frames, 2)
+
+	resGetV := &rfpb.VersionRes{}
+	assert.NoError(t, proto.Unmarshal(frames[0], resGetV))
+	assert.Equal(t, "v"+metaServiceVersion, resGetV.Version)
+	assert.Contains(t, string(frames[1]), "grpc-status: 0\r\n")
+}
+
+func TestWebBridge_ConnectUnary(t *testing.T) {
+
+	srv := webTestServer(t); defer srv.Close()
+
+	res, err := http.Post(srv.URL+"/aribor.UserInviteCodeService/GetVersion", "application/json", strings.NewReader("{}"))
+	if err != nil { t.Fatal(err) }; defer res.Body.Close()
+
+	resGetV := map[string]string{}
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(&resGetV))
+	assert.Equal(t, "v"+metaServiceVersion, resGetV["version"])
+}
+
+func TestWebBridge_ConnectUnknownMethod(t *testing.T) {
+
+	srv := webTestServer(t); defer srv.Close()
+
+	res, err := http.Post(srv.URL+"/aribor.UserInviteCodeService/Unknown", "application/json", strings.NewReader("{}"))
+	if err != nil { t.Fatal(err) }; defer res.Body.Close()
+
+	connectErr := webConnectError{}
+	assert.Equal(t, http.StatusNotImplemented, res.StatusCode)
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(&connectErr))
+	assert.Equal(t, "unimplemented", connectErr.Code)
+}
+
+func TestWebBridge_ConnectMaxRequestSize(t *testing.T) {
+
+	srv := webTestServer(t); defer srv.Close()
+
+	// an oversized body is rejected instead of being truncated to a (possibly valid) message
+	body := "{}" + strings.Repeat(" ", metaWebMaxRequestSize-1)
+	res, err := http.Post(srv.URL+"/aribor.UserInviteCodeService/GetVersion", "application/json", strings.NewReader(body))
+	if err != nil { t.Fatal(err) }; defer res.Body.Close()
+
+	connectErr := webConnectError{}
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(&connectErr))
+	assert.Equal(t, "resource_exhausted", connectErr.Code)
+
+	res, err = http.Post(srv.URL+"/aribor.UserInviteCodeService/GetVersion", "application/json", strings.NewReader(body[:metaWebMaxRequestSize]))
+	if err != nil { t.Fatal(err) }; defer res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestWebBridge_ConnectIfMatch(t *testing.T) {
+
+	srv := webTestServer(t); defer srv.Close()
+
+	// the if-match header reaches the handler as metadata, a weak etag is rejected before mongodb is queried
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/aribor.UserInviteCodeService/DeleteInviteCode",
+		strings.NewReader(`{"id":"5f5a0f3e1c9d440000a1b2c3"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `W/"1"`)
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil { t.Fatal(err) }; defer res.Body.Close()
+
+	connectErr := webConnectError{}
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(&connectErr))
+	assert.Equal(t, "invalid_argument", connectErr.Code)
+	assert.Contains(t, connectErr.Message, "if-match")
+}
+
+func TestWebBridge_Cors(t *testing.T) {
+
+	srv := webTestServer(t); defer srv.Close()
+
+	preflight := func(origin string) *http.Response {
+		req, _ := http.NewRequest(http.MethodOptions, srv.URL+"/aribor.UserInviteCodeService/GetVersion", nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		res, err := http.DefaultClient.Do(req)
+		if err != nil { t.Fatal(err) }
+		return res
+	}
+
+	resAllowed := preflight("https://app.relicfrog.com")
+	assert.Equal(t, http.StatusNoContent, resAllowed.StatusCode)
+	assert.Equal(t, "https://app.relicfrog.com", resAllowed.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, metaWebCorsMaxAge, resAllowed.Header.Get("Access-Control-Max-Age"))
+
+	resDenied := preflight("https://evil.example.com")
+	assert.Equal(t, http.StatusForbidden, resDenied.StatusCode)
+	assert.Empty(t, resDenied.Header.Get("Access-Control-Allow-Origin"))
+}
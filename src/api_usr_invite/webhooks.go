@@ -0,0 +1,501 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	metaWebhookCollectionTbl = "webhook_deliveries"
+	metaWebhookQueueIndex    = "status_next_attempt_at"
+
+	metaWebhookStatusPending   = "pending"
+	metaWebhookStatusDelivered = "delivered"
+	metaWebhookStatusFailed    = "failed"
+
+	metaWebhookHeaderId        = "X-Webhook-Id"
+	metaWebhookHeaderEvent     = "X-Webhook-Event"
+	metaWebhookHeaderTimestamp = "X-Webhook-Timestamp"
+	metaWebhookHeaderSignature = "X-Webhook-Signature"
+
+	metaWebhookBatch        = 100
+	metaWebhookListLimit    = 100
+	metaWebhookListMaxLimit = 1000
+	metaWebhookMaxBody      = 4096
+)
+
+var (
+	// webhookEventTypes lists all event types endpoints can subscribe to (redeemed is reserved, never emitted yet)
+	webhookEventTypes = []string{metaInviteCodeEventCreated, metaInviteCodeEventDeleted, metaInviteCodeEventRedeemed,
+		string(expiryEventExpiringSoon), string(expiryEventExpired)}
+
+	webhookWorkerOnce sync.Once
+)
+
+// webhookEndpoint receives the events listed (all events if empty) as signed JSON POST requests
+type webhookEndpoint struct {
+	Name   string   `json:"name"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+// webhookEndpointSet is the content of WEBHOOK_ENDPOINTS_FILE
+type webhookEndpointSet struct {
+	Endpoints []webhookEndpoint `json:"endpoints"`
+}
+
+// webhookDelivery is queued per event and endpoint in webhook_deliveries, so pending events survive restarts
+type webhookDelivery struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	Endpoint       string             `bson:"endpoint"`
+	URL            string             `bson:"url"`
+	EventID        string             `bson:"event_id"`
+	EventType      string             `bson:"event_type"`
+	Payload        []byte             `bson:"payload"`
+	Status         string             `bson:"status"`
+	Attempts       int                `bson:"attempts"`
+	LastStatusCode int                `bson:"last_status_code"`
+	LastError      string             `bson:"last_error"`
+	CreatedAt      time.Time          `bson:"created_at"`
+	NextAttemptAt  time.Time          `bson:"next_attempt_at"`
+	DeliveredAt    time.Time          `bson:"delivered_at,omitempty"`
+}
+
+// webhookDeliveryFilter selects deliveries of the delivery log, empty fields match all
+type webhookDeliveryFilter struct {
+	Endpoint  string
+	EventType string
+	Status    string
+}
+
+// webhookStore queues deliveries, a claimed delivery is leased (next_attempt_at) until its result is saved, so a
+// delivery interrupted by a shutdown is retried by the next worker.
+type webhookStore interface {
+	enqueue(ctx context.Context, deliveries []webhookDelivery) error
+	claim(ctx context.Context, now time.Time, lease time.Duration) (*webhookDelivery, error)
+	save(ctx context.Context, delivery *webhookDelivery) error
+	list(ctx context.Context, filter webhookDeliveryFilter, limit int) ([]webhookDelivery, error)
+}
+
+// webhookDispatcher queues the events of the configured endpoints and delivers them with exponential backoff
+type webhookDispatcher struct {
+	store       webhookStore
+	endpoints   []webhookEndpoint
+	client      *http.Client
+	signingKey  func() string
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	now         func() time.Time
+}
+
+type mongoDbWebhookStore struct {
+	collection func() *mongo.Collection
+}
+
+//
+// -- gRPC Webhook Stack 22/n :: outbound webhooks (signed payloads, persistent delivery queue)
+//
+
+// newWebhookDispatcher loads the endpoints of WEBHOOK_ENDPOINTS_FILE, without file no events are queued
+func newWebhookDispatcher(cfg *Config) (*webhookDispatcher, error) {
+
+	d := &webhookDispatcher{
+		store:       &mongoDbWebhookStore{collection: func() *mongo.Collection { return mongoDbCurrent().database.Collection(metaWebhookCollectionTbl) }},
+		client:      &http.Client{Timeout: cfg.WebhookTimeout},
+		signingKey:  metaSecrets.getSigningKey,
+		maxAttempts: cfg.WebhookMaxAttempts,
+		backoff:     cfg.WebhookBackoff,
+		maxBackoff:  cfg.WebhookMaxBackoff,
+		now:         time.Now,
+	}
+
+	if cfg.WebhookEndpointsFile == "" {
+		return d, nil
+	}
+
+	set, err := loadWebhookEndpointSet(cfg.WebhookEndpointsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(set.Endpoints) > 0 && d.signingKey() == "" {
+		return nil, fmt.Errorf("webhook endpoints require SIGNING_KEY")
+	}
+
+	d.endpoints = set.Endpoints
+	log.Infof("%s: webhooks: %d endpoint(s) loaded from [%s]",metaServiceName,len(d.endpoints),cfg.WebhookEndpointsFile)
+
+	return d, nil
+}
+
+func loadWebhookEndpointSet(file string) (*webhookEndpointSet, error) {
+
+	raw, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read webhook endpoint file [%s]: %v", file, err)
+	}
+
+	set := &webhookEndpointSet{}
+	if err := json.Unmarshal(raw, set); err != nil {
+		return nil, fmt.Errorf("unable to parse webhook endpoint file [%s]: %v", file, err)
+	}
+
+	names := map[string]bool{}
+	for i, endpoint := range set.Endpoints {
+		if endpoint.Name == "" || names[endpoint.Name] {
+			return nil, fmt.Errorf("webhook endpoint #%d: name must be set and unique", i)
+		}
+		names[endpoint.Name] = true
+
+		if u, err := url.Parse(endpoint.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("webhook endpoint [%s]: invalid url [%s]", endpoint.Name, endpoint.URL)
+		}
+
+		for _, event := range endpoint.Events {
+			if !_isMongoDbOption(webhookEventTypes, event) {
+				return nil, fmt.Errorf("webhook endpoint [%s]: unknown event [%s]", endpoint.Name, event)
+			}
+		}
+	}
+
+	return set, nil
+}
+
+// publish queues the event for all endpoints subscribed to its type
+func (d *webhookDispatcher) publish(ctx context.Context, event inviteCodeEvent) error {
+
+	deliveries, err := d._getDeliveries(event)
+	if err != nil || len(deliveries) == 0 {
+		return err
+	}
+
+	if err := d.store.enqueue(ctx, deliveries); err != nil {
+		return fmt.Errorf("unable to queue webhook event %s: %v", event.Type, err)
+	}
+
+	return nil
+}
+
+// deliverDue delivers up to batch due deliveries, it stops early once ctx is done (the claim expires)
+func (d *webhookDispatcher) deliverDue(ctx context.Context) (int, error) {
+
+	delivered := 0
+	for i := 0; i < metaWebhookBatch; i++ {
+		delivery, err := d.store.claim(ctx, d.now(), d.client.Timeout*2)
+		if err != nil || delivery == nil {
+			return delivered, err
+		}
+
+		d.deliver(ctx, delivery)
+		if ctx.Err() != nil {
+			return delivered, ctx.Err()
+		}
+
+		if err := d.store.save(ctx, delivery); err != nil {
+			return delivered, fmt.Errorf("unable to save webhook delivery [%s]: %v", delivery.ID.Hex(), err)
+		}
+		if delivery.Status == metaWebhookStatusDelivered {
+			delivered++
+		}
+	}
+
+	return delivered, nil
+}
+
+// deliver sends the delivery once and records the result, failed attempts are retried with exponential backoff
+// until WEBHOOK_MAX_ATTEMPTS is reached.
+func (d *webhookDispatcher) deliver(ctx context.Context, delivery *webhookDelivery) {
+
+	now := d.now()
+	delivery.Attempts++
+
+	statusCode, err := d._post(ctx, delivery, now)
+	delivery.LastStatusCode = statusCode
+	if err == nil {
+		delivery.Status, delivery.DeliveredAt, delivery.LastError = metaWebhookStatusDelivered, now, ""
+		log.Debugf("%s: webhooks: delivered %s [%s] to [%s]",metaServiceName,delivery.EventType,delivery.EventID,delivery.Endpoint)
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= d.maxAttempts {
+		delivery.Status = metaWebhookStatusFailed
+		log.Warnf("%s: webhooks: giving up %s [%s] to [%s] after %d attempt(s): %v",metaServiceName,delivery.EventType,delivery.EventID,delivery.Endpoint,delivery.Attempts,err)
+		return
+	}
+
+	delivery.NextAttemptAt = now.Add(_getExponentialBackoff(d.backoff, d.maxBackoff, delivery.Attempts))
+	log.Infof("%s: webhooks: attempt #%d of %s [%s] to [%s] failed, retry at %s: %v",metaServiceName,delivery.Attempts,delivery.EventType,delivery.EventID,delivery.Endpoint,delivery.NextAttemptAt.Format(time.RFC3339),err)
+}
+
+// startWorker delivers due deliveries periodically (once per process) until the service drains, pending
+// deliveries stay queued in mongodb for the next start.
+func (d *webhookDispatcher) startWorker(interval time.Duration) {
+
+	if len(d.endpoints) == 0 || interval <= 0 {
+		return
+	}
+
+	webhookWorkerOnce.Do(func() {
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-runtimeDrainStarted():
+					log.Infof("%s: webhooks: worker stopped, pending deliveries stay queued",metaServiceName)
+					return
+				case <-ticker.C:
+				}
+
+				// an in-flight batch completes while draining, it is cancelled on shutdown (claims expire)
+				workerCtx, cancel := mongoDbOperationContext(context.Background(), 0)
+				if _, err := d.deliverDue(workerCtx); err != nil && workerCtx.Err() == nil {
+					log.Warnf("%s: webhooks: %v",metaServiceName,err)
+				}
+				cancel()
+			}
+		}()
+	})
+}
+
+// webhookSignature returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>", receivers recompute it using the
+// shared SIGNING_KEY and reject old timestamps (replays).
+func webhookSignature(key string, timestamp string, body []byte) string {
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookExpirySink queues the expiry events of the expiry scheduler (INVITE_CODE_EXPIRY_SINK=webhook)
+type webhookExpirySink struct{}
+
+func (webhookExpirySink) emit(ctx context.Context, event expiryEvent) error {
+
+	if metaWebhooks == nil {
+		return nil
+	}
+
+	oid, err := primitive.ObjectIDFromHex(event.ID)
+	if err != nil {
+		return err
+	}
+
+	inviteCode := &UserInviteCode{ID: oid, MetaCode: event.MetaCode, MetaForAppRole: event.MetaForAppRole, MetaValidTo: event.MetaValidTo}
+
+	return metaWebhooks.publish(ctx, newInviteCodeEvent(primitive.NewObjectID(), string(event.Type), event.EmittedAt, inviteCode))
+}
+
+func init() {
+	registerExpirySink("webhook", func(*Config) (expirySink, error) { return webhookExpirySink{}, nil })
+}
+
+func (s *mongoDbWebhookStore) enqueue(ctx context.Context, deliveries []webhookDelivery) error {
+
+	documents := make([]interface{}, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		documents = append(documents, delivery)
+	}
+
+	_, err := s.collection().InsertMany(ctx, documents)
+
+	return err
+}
+
+func (s *mongoDbWebhookStore) claim(ctx context.Context, now time.Time, lease time.Duration) (*webhookDelivery, error) {
+
+	delivery := &webhookDelivery{}
+	err := s.collection().FindOneAndUpdate(ctx,
+		bson.M{"status": metaWebhookStatusPending, "next_attempt_at": bson.M{"$lte": now}},
+		bson.M{"$set": bson.M{"next_attempt_at": now.Add(lease)}},
+		options.FindOneAndUpdate().SetSort(bson.M{"next_attempt_at": 1}).SetReturnDocument(options.After),
+	).Decode(delivery)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+
+	return delivery, err
+}
+
+func (s *mongoDbWebhookStore) save(ctx context.Context, delivery *webhookDelivery) error {
+
+	_, err := s.collection().UpdateOne(ctx, bson.M{"_id": delivery.ID}, bson.M{"$set": bson.M{
+		"status":           delivery.Status,
+		"attempts":         delivery.Attempts,
+		"last_status_code": delivery.LastStatusCode,
+		"last_error":       delivery.LastError,
+		"next_attempt_at":  delivery.NextAttemptAt,
+		"delivered_at":     delivery.DeliveredAt,
+	}})
+
+	return err
+}
+
+func (s *mongoDbWebhookStore) list(ctx context.Context, filter webhookDeliveryFilter, limit int) ([]webhookDelivery, error) {
+
+	query := bson.M{}
+	for field, value := range map[string]string{"endpoint": filter.Endpoint, "event_type": filter.EventType, "status": filter.Status} {
+		if value != "" {
+			query[field] = value
+		}
+	}
+
+	cursor, err := s.collection().Find(ctx, query, options.Find().SetSort(bson.M{"created_at": -1}).SetLimit(int64(limit)))
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []webhookDelivery
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// _validateWebhooks returns all webhook configuration errors
+func (c *Config) _validateWebhooks() []string {
+
+	var errs []string
+	if c.WebhookMaxAttempts < 1 {
+		errs = append(errs, fmt.Sprintf("WEBHOOK_MAX_ATTEMPTS: must be at least 1, got %d", c.WebhookMaxAttempts))
+	}
+
+	if c.WebhookBackoff <= 0 || c.WebhookMaxBackoff < c.WebhookBackoff {
+		errs = append(errs, fmt.Sprintf("WEBHOOK_BACKOFF/WEBHOOK_MAX_BACKOFF: must be positive and max >= initial, got %v/%v", c.WebhookBackoff, c.WebhookMaxBackoff))
+	}
+
+	if c.WebhookTimeout <= 0 {
+		errs = append(errs, fmt.Sprintf("WEBHOOK_TIMEOUT: must be positive, got %v", c.WebhookTimeout))
+	}
+
+	if c.WebhookPollInterval < 0 {
+		errs = append(errs, fmt.Sprintf("WEBHOOK_POLL_INTERVAL: must not be negative, got %v", c.WebhookPollInterval))
+	}
+
+	return errs
+}
+
+//
+// -- sidekick stack for webhook helper methods
+//
+
+func (d *webhookDispatcher) _getDeliveries(event inviteCodeEvent) ([]webhookDelivery, error) {
+
+	var endpoints []webhookEndpoint
+	for _, endpoint := range d.endpoints {
+		if len(endpoint.Events) == 0 || _isMongoDbOption(endpoint.Events, event.Type) {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	if len(endpoints) == 0 {
+		return nil, nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode webhook event %s: %v", event.Type, err)
+	}
+
+	now := d.now().UTC().Truncate(time.Millisecond)
+	deliveries := make([]webhookDelivery, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		deliveries = append(deliveries, webhookDelivery{
+			ID: primitive.NewObjectID(), Endpoint: endpoint.Name, URL: endpoint.URL, EventID: event.ID, EventType: event.Type,
+			Payload: payload, Status: metaWebhookStatusPending, CreatedAt: now, NextAttemptAt: now,
+		})
+	}
+
+	return deliveries, nil
+}
+
+func (d *webhookDispatcher) _post(ctx context.Context, delivery *webhookDelivery, now time.Time) (int, error) {
+
+	key := d.signingKey()
+	if key == "" {
+		return 0, errors.New("no SIGNING_KEY set")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", fmt.Sprintf("%s/%s", metaServiceName, metaServiceVersion))
+	req.Header.Set(metaWebhookHeaderId, delivery.EventID)
+	req.Header.Set(metaWebhookHeaderEvent, delivery.EventType)
+	req.Header.Set(metaWebhookHeaderTimestamp, timestamp)
+	req.Header.Set(metaWebhookHeaderSignature, webhookSignature(key, timestamp, delivery.Payload))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(io.LimitReader(res.Body, metaWebhookMaxBody))
+		return res.StatusCode, fmt.Errorf("endpoint answered %s: %s", res.Status, bytes.TrimSpace(body))
+	}
+
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+
+	return res.StatusCode, nil
+}
+
+// _getExponentialBackoff returns the backoff after the given number of attempts (initial, doubled per attempt, capped)
+func _getExponentialBackoff(initial time.Duration, max time.Duration, attempts int) time.Duration {
+
+	backoff := initial
+	for i := 1; i < attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+
+	if backoff > max {
+		return max
+	}
+
+	return backoff
+}
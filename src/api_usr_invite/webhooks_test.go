@@ -0,0 +1,330 @@
+// Copyright 2020 Team RelicFrog
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// ʕ◔ϖ◔ʔ
+//
+
+package main
+
+import (
+	rfpb "api_usr_invite/server/proto"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+const metaWebhookTestKey = "0123456789abcdef0123456789abcdef"
+
+// webhookTestStore queues deliveries in memory
+type webhookTestStore struct {
+	deliveries []webhookDelivery
+}
+
+// webhookTestReceiver verifies the signature of received events, it answers with the queued status codes (200 if empty)
+type webhookTestReceiver struct {
+	mu       sync.Mutex
+	statuses []int
+	events   []inviteCodeEvent
+	invalid  int
+}
+
+//
+// -- core test helper methods :: *.n
+//
+
+func (s *webhookTestStore) enqueue(_ context.Context, deliveries []webhookDelivery) error {
+
+	s.deliveries = append(s.deliveries, deliveries...)
+
+	return nil
+}
+
+func (s *webhookTestStore) claim(_ context.Context, now time.Time, lease time.Duration) (*webhookDelivery, error) {
+
+	sort.SliceStable(s.deliveries, func(i, j int) bool { return s.deliveries[i].NextAttemptAt.Before(s.deliveries[j].NextAttemptAt) })
+	for i, delivery := range s.deliveries {
+		if delivery.Status == metaWebhookStatusPending && !delivery.NextAttemptAt.After(now) {
+			s.deliveries[i].NextAttemptAt = now.Add(lease)
+			claimed := s.deliveries[i]
+			return &claimed, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *webhookTestStore) save(_ context.Context, delivery *webhookDelivery) error {
+
+	for i := range s.deliveries {
+		if s.deliveries[i].ID == delivery.ID {
+			s.deliveries[i] = *delivery
+		}
+	}
+
+	return nil
+}
+
+func (s *webhookTestStore) list(_ context.Context, filter webhookDeliveryFilter, limit int) ([]webhookDelivery, error) {
+
+	var deliveries []webhookDelivery
+	for i := len(s.deliveries) - 1; i >= 0 && len(deliveries) < limit; i-- {
+		delivery := s.deliveries[i]
+		if (filter.Endpoint == "" || filter.Endpoint == delivery.Endpoint) && (filter.EventType == "" || filter.EventType == delivery.EventType) && (filter.Status == "" || filter.Status == delivery.Status) {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+
+	return deliveries, nil
+}
+
+func (r *webhookTestReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	body, _ := ioutil.ReadAll(req.Body)
+	if req.Header.Get(metaWebhookHeaderSignature) != webhookSignature(metaWebhookTestKey, req.Header.Get(metaWebhookHeaderTimestamp), body) {
+		r.invalid++
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	event := inviteCodeEvent{}
+	_ = json.Unmarshal(body, &event)
+	r.events = append(r.events, event)
+
+	code := http.StatusOK
+	if len(r.statuses) > 0 {
+		code, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(code)
+}
+
+// webhookTestDispatcher returns a dispatcher of the given endpoints using a fixed clock
+func webhookTestDispatcher(now time.Time, endpoints ...webhookEndpoint) (*webhookDispatcher, *webhookTestStore) {
+
+	store := &webhookTestStore{}
+
+	return &webhookDispatcher{
+		store: store, endpoints: endpoints, client: &http.Client{Timeout: time.Second},
+		signingKey: func() string { return metaWebhookTestKey }, maxAttempts: 3, backoff: 5 * time.Second, maxBackoff: time.Minute,
+		now: func() time.Time { return now },
+	}, store
+}
+
+func webhookTestEvent(eventType string, inviteCode *UserInviteCode) inviteCodeEvent {
+	return newInviteCodeEvent(primitive.NewObjectID(), eventType, time.Now(), inviteCode)
+}
+
+func webhookTestInviteCode() *UserInviteCode {
+	return &UserInviteCode{ID: primitive.NewObjectID(), MetaCode: "hook", MetaForAppRole: "teacher", MetaValidTo: time.Now().Add(time.Hour)}
+}
+
+//
+// -- core test methods :: outbound webhooks
+//
+
+func TestWebhooks_Delivery(t *testing.T) {
+
+	receiver := &webhookTestReceiver{}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	now := time.Now()
+	d, store := webhookTestDispatcher(now,
+		webhookEndpoint{Name: "all", URL: server.URL},
+		webhookEndpoint{Name: "deleted", URL: server.URL, Events: []string{metaInviteCodeEventDeleted}})
+
+	inviteCode := webhookTestInviteCode()
+	assert.NoError(t, d.publish(ctx, webhookTestEvent(metaInviteCodeEventCreated, inviteCode)))
+	assert.Len(t, store.deliveries, 1, "endpoint filter applied")
+	assert.NoError(t, d.publish(ctx, webhookTestEvent(metaInviteCodeEventDeleted, inviteCode)))
+	assert.Len(t, store.deliveries, 3)
+
+	delivered, err := d.deliverDue(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, delivered)
+	assert.Zero(t, receiver.invalid)
+	assert.Len(t, receiver.events, 3)
+	assert.Equal(t, metaInviteCodeEventCreated, receiver.events[0].Type)
+	assert.Equal(t, inviteCode.ID.Hex(), receiver.events[0].InviteCode.ID)
+	assert.Equal(t, receiver.events[1].ID, receiver.events[2].ID, "one event id per event")
+
+	for _, delivery := range store.deliveries {
+		assert.Equal(t, metaWebhookStatusDelivered, delivery.Status)
+		assert.Equal(t, http.StatusOK, delivery.LastStatusCode)
+		assert.Equal(t, 1, delivery.Attempts)
+	}
+
+	// a changed key is detected by the receiver
+	d.signingKey = func() string { return "rotated-" + metaWebhookTestKey }
+	assert.NoError(t, d.publish(ctx, webhookTestEvent(metaInviteCodeEventCreated, inviteCode)))
+	_, _ = d.deliverDue(ctx)
+	assert.Equal(t, 1, receiver.invalid)
+	assert.Equal(t, http.StatusUnauthorized, store.deliveries[3].LastStatusCode)
+}
+
+func TestWebhooks_RetryWithBackoff(t *testing.T) {
+
+	receiver := &webhookTestReceiver{statuses: []int{http.StatusInternalServerError, http.StatusBadGateway}}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	now := time.Now()
+	d, store := webhookTestDispatcher(now, webhookEndpoint{Name: "flaky", URL: server.URL})
+	d.now = func() time.Time { return now }
+	assert.NoError(t, d.publish(ctx, webhookTestEvent(metaInviteCodeEventCreated, webhookTestInviteCode())))
+
+	// 1st attempt fails, retry after 5s
+	delivered, err := d.deliverDue(ctx)
+	assert.NoError(t, err)
+	assert.Zero(t, delivered)
+	assert.Equal(t, metaWebhookStatusPending, store.deliveries[0].Status)
+	assert.Equal(t, http.StatusInternalServerError, store.deliveries[0].LastStatusCode)
+	assert.Equal(t, now.Add(5*time.Second), store.deliveries[0].NextAttemptAt)
+
+	// not due yet
+	delivered, _ = d.deliverDue(ctx)
+	assert.Zero(t, delivered)
+	assert.Len(t, receiver.events, 1)
+
+	// 2nd attempt fails, retry after 10s
+	now = now.Add(5 * time.Second)
+	_, _ = d.deliverDue(ctx)
+	assert.Equal(t, now.Add(10*time.Second), store.deliveries[0].NextAttemptAt)
+
+	now = now.Add(10 * time.Second)
+	delivered, _ = d.deliverDue(ctx)
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, metaWebhookStatusDelivered, store.deliveries[0].Status)
+	assert.Equal(t, 3, store.deliveries[0].Attempts)
+	assert.Empty(t, store.deliveries[0].LastError)
+	assert.Equal(t, now, store.deliveries[0].DeliveredAt)
+
+	// after max. attempts the delivery fails
+	receiver.statuses = []int{500, 500, 500}
+	assert.NoError(t, d.publish(ctx, webhookTestEvent(metaInviteCodeEventCreated, webhookTestInviteCode())))
+	for i := 0; i < 3; i++ {
+		_, _ = d.deliverDue(ctx)
+		now = now.Add(time.Minute)
+	}
+	assert.Equal(t, metaWebhookStatusFailed, store.deliveries[1].Status)
+	assert.Equal(t, 3, store.deliveries[1].Attempts)
+	assert.Contains(t, store.deliveries[1].LastError, "500")
+}
+
+func TestWebhooks_InterruptedDelivery(t *testing.T) {
+
+	receiver := &webhookTestReceiver{}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	now := time.Now()
+	d, store := webhookTestDispatcher(now, webhookEndpoint{Name: "all", URL: server.URL})
+	d.now = func() time.Time { return now }
+	assert.NoError(t, d.publish(ctx, webhookTestEvent(metaInviteCodeEventCreated, webhookTestInviteCode())))
+
+	// a shutdown during delivery keeps the delivery queued, it is retried once the claim expired
+	stopped, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err := d.deliverDue(stopped)
+	assert.Error(t, err)
+	assert.Equal(t, metaWebhookStatusPending, store.deliveries[0].Status)
+	assert.Equal(t, now.Add(2*time.Second), store.deliveries[0].NextAttemptAt, "claimed")
+
+	now = now.Add(2 * time.Second)
+	delivered, err := d.deliverDue(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+}
+
+func TestWebhooks_Backoff(t *testing.T) {
+
+	for attempts, expected := range map[int]time.Duration{1: 5 * time.Second, 2: 10 * time.Second, 4: 40 * time.Second, 5: time.Minute, 30: time.Minute} {
+		assert.Equal(t, expected, _getExponentialBackoff(5*time.Second, time.Minute, attempts), attempts)
+	}
+}
+
+func TestWebhooks_Signature(t *testing.T) {
+
+	signature := webhookSignature(metaWebhookTestKey, "1601510400", []byte(`{"id":"1"}`))
+	assert.Regexp(t, "^v1=[0-9a-f]{64}$", signature)
+	assert.Equal(t, signature, webhookSignature(metaWebhookTestKey, "1601510400", []byte(`{"id":"1"}`)))
+	assert.NotEqual(t, signature, webhookSignature(metaWebhookTestKey, "1601510401", []byte(`{"id":"1"}`)))
+}
+
+func TestWebhooks_Endpoints(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "webhooks")
+	if err != nil { t.Fatal(err) }
+	defer os.RemoveAll(dir)
+
+	write := func(content string) string {
+		file := filepath.Join(dir, "endpoints.json")
+		if err := ioutil.WriteFile(file, []byte(content), 0600); err != nil { t.Fatal(err) }
+		return file
+	}
+
+	set, err := loadWebhookEndpointSet(write(`{"endpoints":[{"name":"crm","url":"https://crm.example.com/hooks","events":["invite_code.created","invite_code.expired"]}]}`))
+	assert.NoError(t, err)
+	assert.Len(t, set.Endpoints, 1)
+
+	for _, content := range []string{
+		`{"endpoints":[{"name":"crm","url":"https://a"},{"name":"crm","url":"https://b"}]}`,
+		`{"endpoints":[{"name":"crm","url":"ftp://crm.example.com"}]}`,
+		`{"endpoints":[{"name":"crm","url":"https://crm.example.com","events":["invite_code.renamed"]}]}`,
+		`{"endpoints":`,
+	} {
+		_, err := loadWebhookEndpointSet(write(content))
+		assert.Error(t, err, content)
+	}
+
+	cfg := &Config{WebhookMaxAttempts: 0, WebhookBackoff: time.Minute, WebhookMaxBackoff: time.Second, WebhookTimeout: 0, WebhookPollInterval: -time.Second}
+	assert.Len(t, cfg._validateWebhooks(), 4)
+}
+
+func TestWebhooks_AdminListDeliveries(t *testing.T) {
+
+	webhooks := metaWebhooks
+	defer func() { metaWebhooks = webhooks }()
+
+	d, store := webhookTestDispatcher(time.Now(), webhookEndpoint{Name: "a", URL: "http://localhost"}, webhookEndpoint{Name: "b", URL: "http://localhost"})
+	metaWebhooks = d
+	for i := 0; i < 60; i++ {
+		assert.NoError(t, d.publish(ctx, webhookTestEvent(metaInviteCodeEventCreated, webhookTestInviteCode())))
+	}
+	store.deliveries[0].Status = metaWebhookStatusFailed
+
+	res, err := AdminServiceServer{}.ListWebhookDeliveries(ctx, &rfpb.ListWebhookDeliveriesReq{})
+	assert.NoError(t, err)
+	assert.Len(t, res.GetDeliveries(), metaWebhookListLimit)
+	assert.Equal(t, store.deliveries[119].ID.Hex(), res.GetDeliveries()[0].GetId(), "newest first")
+	assert.NotNil(t, res.GetDeliveries()[0].GetNextAttemptAt())
+
+	res, err = AdminServiceServer{}.ListWebhookDeliveries(ctx, &rfpb.ListWebhookDeliveriesReq{Endpoint: "a", Status: metaWebhookStatusFailed})
+	assert.NoError(t, err)
+	assert.Len(t, res.GetDeliveries(), 1)
+	assert.Equal(t, "a", res.GetDeliveries()[0].GetEndpoint())
+	assert.Nil(t, res.GetDeliveries()[0].GetNextAttemptAt())
+}